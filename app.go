@@ -1,12 +1,13 @@
 package podio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
 
 type App struct {
-	Id            int64  `json:"app_id"`
+	Id            AppID  `json:"app_id"`
 	Name          string `json:"name"`
 	Status        string `json:"status"`
 	DefaultViewId int    `json:"default_view_id"`
@@ -15,21 +16,26 @@ type App struct {
 	LinkAdd         string `json:"link_add"`
 	CurrentRevision int    `json:"current_revision"`
 	// ItemName        string `json:"item_name"`
-	Link     string `json:"link"`
-	URL      string `json:"url"`
-	URLLabel string `json:"url_label"`
-	SpaceId  int    `json:"space_id"`
-	Icon     string `json:"icon"`
-	IconId   int    `json:"icon_id"`
-	APIToken string `json:"token"`
-
-	Fields   []AppField `json:"fields"`
-	Config   AppConfig  `json:"config"`
-	Layouts  AppLayouts `json:"layouts"`
-	Owner    AppRef     `json:"owner"`
+	Link     string  `json:"link"`
+	URL      string  `json:"url"`
+	URLLabel string  `json:"url_label"`
+	SpaceId  SpaceID `json:"space_id"`
+	Icon     string  `json:"icon"`
+	IconId   int     `json:"icon_id"`
+	APIToken string  `json:"token"`
+
+	Fields   []AppField `json:"fields,omitempty"`
+	Config   AppConfig  `json:"config,omitzero"`
+	Layouts  AppLayouts `json:"layouts,omitzero"`
+	Owner    AppRef     `json:"owner,omitzero"`
 	Original *int64     `json:"original"`
 }
 
+func (app App) MarshalJSON() ([]byte, error) {
+	type plain App
+	return marshalOmitZero(plain(app))
+}
+
 type AppConfig struct {
 	Name        string  `json:"name"`
 	ItemName    string  `json:"item_name"`
@@ -75,7 +81,7 @@ type AppLayouts struct {
 }
 
 type AppLayout struct {
-	Fields json.RawMessage `json:"fields"`
+	Fields json.RawMessage `json:"fields,omitempty"`
 }
 
 type AppRef struct {
@@ -85,49 +91,84 @@ type AppRef struct {
 
 // when we create an app we only get the id
 type appIdResponse struct {
-	Id int64 `json:"app_id"`
+	Id AppID `json:"app_id"`
 }
 
 // https://developers.podio.com/doc/applications/get-apps-by-space-22478
 func (client *Client) GetApps(spaceId int64, options map[string]interface{}) (apps []*App, err error) {
+	return client.GetAppsCtx(context.Background(), SpaceID(spaceId), options)
+}
+
+// GetAppsCtx is the context-aware version of GetApps.
+func (client *Client) GetAppsCtx(ctx context.Context, spaceId SpaceID, options map[string]interface{}) (apps []*App, err error) {
 	path := fmt.Sprintf("/app/space/%d", spaceId)
-	err = client.RequestWithParams("GET", path, nil, options, &apps)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, options, &apps)
 	return
 }
 
 // https://developers.podio.com/doc/applications/get-apps-by-space-22478
+//
+// Deprecated: use GetAppsCtx with CaptureResponseWithBody to also get the raw JSON.
 func (client *Client) GetAppsJson(spaceId int64, options map[string]interface{}) (rawResponse *json.RawMessage, err error) {
+	return client.GetAppsJsonCtx(context.Background(), SpaceID(spaceId), options)
+}
+
+// GetAppsJsonCtx is the context-aware version of GetAppsJson.
+//
+// Deprecated: use GetAppsCtx with CaptureResponseWithBody to also get the raw JSON.
+func (client *Client) GetAppsJsonCtx(ctx context.Context, spaceId SpaceID, options map[string]interface{}) (rawResponse *json.RawMessage, err error) {
 	path := fmt.Sprintf("/app/space/%d", spaceId)
-	err = client.RequestWithParams("GET", path, nil, options, &rawResponse)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, options, &rawResponse)
 	return
 }
 
 // https://developers.podio.com/doc/applications/get-app-22349
 func (client *Client) GetApp(id int64) (app *App, err error) {
+	return client.GetAppCtx(context.Background(), AppID(id))
+}
+
+// GetAppCtx is the context-aware version of GetApp.
+func (client *Client) GetAppCtx(ctx context.Context, id AppID) (app *App, err error) {
 	path := fmt.Sprintf("/app/%d", id)
-	err = client.Request("GET", path, nil, nil, &app)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &app)
 	return
 }
 
 // https://developers.podio.com/doc/applications/get-app-on-space-by-url-label-477105
 func (client *Client) GetAppBySpaceIdAndSlug(spaceId int64, slug string) (app *App, err error) {
+	return client.GetAppBySpaceIdAndSlugCtx(context.Background(), SpaceID(spaceId), slug)
+}
+
+// GetAppBySpaceIdAndSlugCtx is the context-aware version of GetAppBySpaceIdAndSlug.
+func (client *Client) GetAppBySpaceIdAndSlugCtx(ctx context.Context, spaceId SpaceID, slug string) (app *App, err error) {
 	path := fmt.Sprintf("/app/space/%d/%s", spaceId, slug)
-	err = client.Request("GET", path, nil, nil, &app)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &app)
 	return
 }
 
 // https://developers.podio.com/doc/applications/get-space-app-dependencies-45779
 func (client *Client) GetSpaceDependencies(spaceId int64) (response *interface{}, err error) {
+	return client.GetSpaceDependenciesCtx(context.Background(), SpaceID(spaceId))
+}
+
+// GetSpaceDependenciesCtx is the context-aware version of GetSpaceDependencies.
+func (client *Client) GetSpaceDependenciesCtx(ctx context.Context, spaceId SpaceID) (response *interface{}, err error) {
 	path := fmt.Sprintf("/space/%d/dependencies", spaceId)
-	err = client.Request("GET", path, nil, nil, &response)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &response)
 	return
 }
 
 // https://developers.podio.com/doc/applications/add-new-app-22351
 func (client *Client) CreateApp(spaceId int64, config map[string]interface{}, fields []AppField) (AppId int64, err error) {
+	id, err := client.CreateAppCtx(context.Background(), SpaceID(spaceId), config, fields)
+	return int64(id), err
+}
+
+// CreateAppCtx is the context-aware version of CreateApp.
+func (client *Client) CreateAppCtx(ctx context.Context, spaceId SpaceID, config map[string]interface{}, fields []AppField) (AppId AppID, err error) {
 	params := map[string]interface{}{"space_id": spaceId, "config": config, "fields": fields}
 	var resp appIdResponse
-	err = client.RequestWithParams("POST", "/app/", nil, params, &resp)
+	err = client.RequestWithParamsCtx(ctx, "POST", "/app/", nil, params, &resp)
 	AppId = resp.Id
 
 	return
@@ -135,30 +176,46 @@ func (client *Client) CreateApp(spaceId int64, config map[string]interface{}, fi
 
 // https://developers.podio.com/doc/applications/update-app-22352
 func (client *Client) UpdateApp(appId int64, config map[string]interface{}) (err error) {
+	return client.UpdateAppCtx(context.Background(), AppID(appId), config)
+}
+
+// UpdateAppCtx is the context-aware version of UpdateApp.
+func (client *Client) UpdateAppCtx(ctx context.Context, appId AppID, config map[string]interface{}) (err error) {
 	path := fmt.Sprintf("/app/%d", appId)
 	params := map[string]interface{}{"config": config}
-	err = client.RequestWithParams("PUT", path, nil, params, nil)
+	err = client.RequestWithParamsCtx(ctx, "PUT", path, nil, params, nil)
 
 	return err
 }
 
 // https://developers.podio.com/doc/applications/update-app-22352
 func (client *Client) UpdateAppRaw(appId int64, configRaw json.RawMessage) (err error) {
+	return client.UpdateAppRawCtx(context.Background(), AppID(appId), configRaw)
+}
+
+// UpdateAppRawCtx is the context-aware version of UpdateAppRaw.
+func (client *Client) UpdateAppRawCtx(ctx context.Context, appId AppID, configRaw json.RawMessage) (err error) {
 	path := fmt.Sprintf("/app/%d", appId)
 	params := map[string]interface{}{"config": configRaw}
-	err = client.RequestWithParams("PUT", path, nil, params, nil)
+	err = client.RequestWithParamsCtx(ctx, "PUT", path, nil, params, nil)
 
 	return err
 }
 
 // https://developers.podio.com/doc/applications/install-app-22506
 func (client *Client) InstallApp(appId, spaceId int64, features []string) (AppId int64, err error) {
+	id, err := client.InstallAppCtx(context.Background(), AppID(appId), SpaceID(spaceId), features)
+	return int64(id), err
+}
+
+// InstallAppCtx is the context-aware version of InstallApp.
+func (client *Client) InstallAppCtx(ctx context.Context, appId AppID, spaceId SpaceID, features []string) (AppId AppID, err error) {
 	// when features is empty, will default to filters ['widgets', 'integration', 'forms', 'flows', 'votings'] (so all except 'items')
 	path := fmt.Sprintf("/app/%d/install", appId)
 	params := map[string]interface{}{"space_id": spaceId, "features": features}
 
 	var resp appIdResponse
-	err = client.RequestWithParams("POST", path, nil, params, &resp)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &resp)
 	AppId = resp.Id
 
 	return
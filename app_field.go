@@ -2,12 +2,13 @@ package podio
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
 
 type AppField struct {
-	Id         int64       `json:"field_id"`
+	Id         FieldID     `json:"field_id"`
 	ExternalId string      `json:"external_id"`
 	Type       string      `json:"type"`
 	Label      string      `json:"label"`
@@ -36,9 +37,15 @@ type revisionResponse struct {
 
 // https://developers.podio.com/doc/applications/add-new-app-field-22354
 func (client *Client) CreateAppField(appId int64, params map[string]interface{}) (AppFieldId int64, err error) {
+	id, err := client.CreateAppFieldCtx(context.Background(), AppID(appId), params)
+	return int64(id), err
+}
+
+// CreateAppFieldCtx is the context-aware version of CreateAppField.
+func (client *Client) CreateAppFieldCtx(ctx context.Context, appId AppID, params map[string]interface{}) (AppFieldId FieldID, err error) {
 	path := fmt.Sprintf("/app/%d/field/", appId)
 	var appField AppField
-	err = client.RequestWithParams("POST", path, nil, params, &appField)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &appField)
 	AppFieldId = appField.Id
 
 	return
@@ -46,10 +53,16 @@ func (client *Client) CreateAppField(appId int64, params map[string]interface{})
 
 // https://developers.podio.com/doc/applications/add-new-app-field-22354
 func (client *Client) CreateAppFieldRawConfig(appId int64, config json.RawMessage) (AppFieldId int64, err error) {
+	id, err := client.CreateAppFieldRawConfigCtx(context.Background(), AppID(appId), config)
+	return int64(id), err
+}
+
+// CreateAppFieldRawConfigCtx is the context-aware version of CreateAppFieldRawConfig.
+func (client *Client) CreateAppFieldRawConfigCtx(ctx context.Context, appId AppID, config json.RawMessage) (AppFieldId FieldID, err error) {
 	path := fmt.Sprintf("/app/%d/field/", appId)
 	var appField AppField
 	body := bytes.NewReader(config)
-	_, _, _, err = client.request("POST", path, nil, body, &appField)
+	err = client.RequestCtx(ctx, "POST", path, nil, body, &appField)
 	AppFieldId = appField.Id
 
 	return
@@ -57,20 +70,30 @@ func (client *Client) CreateAppFieldRawConfig(appId int64, config json.RawMessag
 
 // https://developers.podio.com/doc/applications/update-an-app-field-22356
 func (client *Client) UpdateAppField(appId, appFieldId int64, params map[string]interface{}) (revision int, err error) {
+	return client.UpdateAppFieldCtx(context.Background(), AppID(appId), FieldID(appFieldId), params)
+}
+
+// UpdateAppFieldCtx is the context-aware version of UpdateAppField.
+func (client *Client) UpdateAppFieldCtx(ctx context.Context, appId AppID, appFieldId FieldID, params map[string]interface{}) (revision int, err error) {
 	path := fmt.Sprintf("/app/%d/field/%d", appId, appFieldId)
 	var resp revisionResponse
-	err = client.RequestWithParams("PUT", path, nil, params, &resp)
+	err = client.RequestWithParamsCtx(ctx, "PUT", path, nil, params, &resp)
 	revision = resp.Revision
 	return
 }
 
 // https://developers.podio.com/doc/applications/update-an-app-field-22356
 func (client *Client) UpdateAppFieldRawConfig(appId, appFieldId int64, config json.RawMessage) (int, error) {
+	return client.UpdateAppFieldRawConfigCtx(context.Background(), AppID(appId), FieldID(appFieldId), config)
+}
+
+// UpdateAppFieldRawConfigCtx is the context-aware version of UpdateAppFieldRawConfig.
+func (client *Client) UpdateAppFieldRawConfigCtx(ctx context.Context, appId AppID, appFieldId FieldID, config json.RawMessage) (int, error) {
 	path := fmt.Sprintf("/app/%d/field/%d", appId, appFieldId)
 	var resp revisionResponse
 
 	body := bytes.NewReader(config)
-	_, _, _, err := client.request("PUT", path, nil, body, &resp)
+	err := client.RequestCtx(ctx, "PUT", path, nil, body, &resp)
 	if err != nil {
 		return 0, err
 	}
@@ -80,8 +103,13 @@ func (client *Client) UpdateAppFieldRawConfig(appId, appFieldId int64, config js
 
 // https://developers.podio.com/doc/items/get-field-ranges-24242866
 func (client *Client) GetFieldRange(fieldID int64) (FieldRange, error) {
+	return client.GetFieldRangeCtx(context.Background(), FieldID(fieldID))
+}
+
+// GetFieldRangeCtx is the context-aware version of GetFieldRange.
+func (client *Client) GetFieldRangeCtx(ctx context.Context, fieldID FieldID) (FieldRange, error) {
 	path := fmt.Sprintf("/item/field/%d/range", fieldID)
 	var resp FieldRange
-	err := client.Request("GET", path, nil, nil, &resp)
+	err := client.RequestCtx(ctx, "GET", path, nil, nil, &resp)
 	return resp, err
 }
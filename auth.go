@@ -1,12 +1,14 @@
 package podio
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 )
 
 type AuthToken struct {
@@ -16,9 +18,17 @@ type AuthToken struct {
 	RefreshToken  string                 `json:"refresh_token"`
 	Ref           map[string]interface{} `json:"ref"`
 	TransferToken string                 `json:"transfer_token"`
+
+	// ExpiresAt is computed from ExpiresIn when the token is issued (Podio does not send it)
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func AuthWithUserCredentials(clientId string, clientSecret string, username string, password string, opts ...ClientOption) (*AuthToken, error) {
+	return AuthWithUserCredentialsCtx(context.Background(), clientId, clientSecret, username, password, opts...)
 }
 
-func AuthWithUserCredentials(clientId string, clientSecret string, username string, password string) (*AuthToken, error) {
+// AuthWithUserCredentialsCtx is the context-aware version of AuthWithUserCredentials.
+func AuthWithUserCredentialsCtx(ctx context.Context, clientId string, clientSecret string, username string, password string, opts ...ClientOption) (*AuthToken, error) {
 	data := url.Values{
 		"grant_type":    {"password"},
 		"username":      {username},
@@ -27,10 +37,15 @@ func AuthWithUserCredentials(clientId string, clientSecret string, username stri
 		"client_secret": {clientSecret},
 	}
 
-	return authRequest(data)
+	return NewClient(nil, opts...).authRequest(ctx, data)
 }
 
-func AuthWithAppCredentials(clientId, clientSecret string, appId int64, appToken string) (*AuthToken, error) {
+func AuthWithAppCredentials(clientId, clientSecret string, appId int64, appToken string, opts ...ClientOption) (*AuthToken, error) {
+	return AuthWithAppCredentialsCtx(context.Background(), clientId, clientSecret, appId, appToken, opts...)
+}
+
+// AuthWithAppCredentialsCtx is the context-aware version of AuthWithAppCredentials.
+func AuthWithAppCredentialsCtx(ctx context.Context, clientId, clientSecret string, appId int64, appToken string, opts ...ClientOption) (*AuthToken, error) {
 	data := url.Values{
 		"grant_type":    {"app"},
 		"app_id":        {fmt.Sprintf("%d", appId)},
@@ -39,10 +54,15 @@ func AuthWithAppCredentials(clientId, clientSecret string, appId int64, appToken
 		"client_secret": {clientSecret},
 	}
 
-	return authRequest(data)
+	return NewClient(nil, opts...).authRequest(ctx, data)
+}
+
+func AuthWithAuthCode(clientId, clientSecret, authCode, redirectUri string, opts ...ClientOption) (*AuthToken, error) {
+	return AuthWithAuthCodeCtx(context.Background(), clientId, clientSecret, authCode, redirectUri, opts...)
 }
 
-func AuthWithAuthCode(clientId, clientSecret, authCode, redirectUri string) (*AuthToken, error) {
+// AuthWithAuthCodeCtx is the context-aware version of AuthWithAuthCode.
+func AuthWithAuthCodeCtx(ctx context.Context, clientId, clientSecret, authCode, redirectUri string, opts ...ClientOption) (*AuthToken, error) {
 	data := url.Values{
 		"grant_type":    {"authorization_code"},
 		"client_id":     {clientId},
@@ -51,10 +71,15 @@ func AuthWithAuthCode(clientId, clientSecret, authCode, redirectUri string) (*Au
 		"code":          {authCode},
 	}
 
-	return authRequest(data)
+	return NewClient(nil, opts...).authRequest(ctx, data)
 }
 
-func AuthWithRefreshToken(clientId, clientSecret, refreshToken string) (*AuthToken, error) {
+func AuthWithRefreshToken(clientId, clientSecret, refreshToken string, opts ...ClientOption) (*AuthToken, error) {
+	return AuthWithRefreshTokenCtx(context.Background(), clientId, clientSecret, refreshToken, opts...)
+}
+
+// AuthWithRefreshTokenCtx is the context-aware version of AuthWithRefreshToken.
+func AuthWithRefreshTokenCtx(ctx context.Context, clientId, clientSecret, refreshToken string, opts ...ClientOption) (*AuthToken, error) {
 	data := url.Values{
 		"grant_type":    {"refresh_token"},
 		"refresh_token": {refreshToken},
@@ -62,14 +87,22 @@ func AuthWithRefreshToken(clientId, clientSecret, refreshToken string) (*AuthTok
 		"client_secret": {clientSecret},
 	}
 
-	return authRequest(data)
+	return NewClient(nil, opts...).authRequest(ctx, data)
 }
 
 
-func authRequest(data url.Values) (*AuthToken, error) {
+func (client *Client) authRequest(ctx context.Context, data url.Values) (*AuthToken, error) {
 	var authToken AuthToken
 
-	resp, err := http.PostForm("https://api.podio.com/oauth/token", data)
+	ctx = withRequestInfo(ctx, &RequestInfo{Kind: RequestKindAuth, Method: "POST", Path: "/oauth/token", Attempt: 1})
+	req, err := http.NewRequestWithContext(ctx, "POST", client.baseURL+"/oauth/token", strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	client.setUserAgent(req)
+
+	resp, err := client.doer.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -81,18 +114,16 @@ func authRequest(data url.Values) (*AuthToken, error) {
 	}
 
 	if !(200 <= resp.StatusCode && resp.StatusCode <= 299) {
-		podioErr := &Error{}
-		err := json.Unmarshal(respBody, podioErr)
-		if err != nil {
-			return nil, errors.New(string(respBody))
-		}
-		return nil, podioErr
+		return nil, newError(resp, respBody)
 	}
 
 	err = json.Unmarshal(respBody, &authToken)
 	if err != nil {
 		return nil, err
 	}
+	if authToken.ExpiresIn > 0 {
+		authToken.ExpiresAt = time.Now().Add(time.Duration(authToken.ExpiresIn) * time.Second)
+	}
 
 	return &authToken, nil
 }
@@ -1,6 +1,9 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Batch struct {
 	Id         int64  `json:"batch_id"`
@@ -20,7 +23,12 @@ type Batch struct {
 
 // https://developers.podio.com/doc/batch/get-batch-6144225
 func (client *Client) GetBatch(batchId int64) (batch *Batch, err error) {
+	return client.GetBatchCtx(context.Background(), batchId)
+}
+
+// GetBatchCtx is the context-aware version of GetBatch.
+func (client *Client) GetBatchCtx(ctx context.Context, batchId int64) (batch *Batch, err error) {
 	path := fmt.Sprintf("/batch/%d", batchId)
-	err = client.Request("GET", path, nil, nil, &batch)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &batch)
 	return
 }
@@ -8,7 +8,7 @@ type ByLine struct {
 	URL        string `json:"url"`
 	AvatarType string `json:"avatar_type"`
 	AvatarId   int    `json:"avatar_id"`
-	Image      File   `json:"image"`
+	Image      File   `json:"image,omitzero"`
 	LastSeenOn Time   `json:"last_seen_on"`
 
 	Avatar int `json:"avatar"` //deprecated
@@ -19,6 +19,11 @@ type ByLineSimple struct {
 	Type       string `json:"type"`
 }
 
+func (b ByLine) MarshalJSON() ([]byte, error) {
+	type plain ByLine
+	return marshalOmitZero(plain(b))
+}
+
 // Via describes the source of a Podio object
 type Via struct {
 	Id      int64  `json:"id"`
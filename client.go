@@ -4,121 +4,287 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
-	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
+// DefaultBaseURL is the Podio API endpoint used when no WithBaseURL option is given.
+const DefaultBaseURL = "https://api.podio.com"
+
+const defaultUserAgent = "podio-go"
+
+// attemptTimeout caps a single HTTP attempt of an API request
+const attemptTimeout = 5 * time.Minute
+
 type Client struct {
 	httpClient *http.Client
-	authToken  *AuthToken
+	baseURL    string
+	userAgent  string
+
+	// authToken and refreshing are guarded by tokenMu as the token is replaced on refresh
+	tokenMu      sync.Mutex
+	authToken    *AuthToken
+	refreshing   *tokenRefresh
+	tokenStore   TokenStore
+	clientId     string
+	clientSecret string
+
+	rateLimiter *RateLimiter
+	retryPolicy *RetryPolicy
+
+	logger     *slog.Logger
+	metrics    Metrics
+	decodeMode DecodeMode
+
+	// doer is httpClient wrapped in the middleware
+	middleware []Middleware
+	doer       Doer
 }
 
-type Error struct {
-	Parameters interface{} `json:"error_parameters"`
-	Detail     interface{} `json:"error_detail"`
-	Propagate  bool        `json:"error_propagate"`
-	Request    struct {
-		URL   string `json:"url"`
-		Query string `json:"query_string"`
-	} `json:"request"`
-	Description string `json:"error_description"`
-	Type        string `json:"error"`
+// ClientOption configures a Client, see NewClient.
+type ClientOption func(*Client)
+
+// WithBaseURL points the client to another API endpoint, e.g. a proxy or a local test server.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(client *Client) {
+		client.baseURL = strings.TrimRight(baseURL, "/")
+	}
 }
 
-func (p *Error) Error() string {
-	return fmt.Sprintf("%s: %s", p.Type, p.Description)
+// WithHTTPClient replaces the http.Client used for API, auth and file download requests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(client *Client) {
+		client.httpClient = httpClient
+	}
 }
 
-func NewClient(authToken *AuthToken) *Client {
-	return &Client{
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(client *Client) {
+		client.userAgent = userAgent
+	}
+}
+
+func NewClient(authToken *AuthToken, opts ...ClientOption) *Client {
+	client := &Client{
 		httpClient: &http.Client{
 			Timeout: 5 * time.Minute,
 		},
-		authToken: authToken,
+		authToken:   authToken,
+		baseURL:     DefaultBaseURL,
+		userAgent:   defaultUserAgent,
+		rateLimiter: NewRateLimiter(),
+		retryPolicy: DefaultRetryPolicy(),
 	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	client.doer = buildDoer(client.httpClient, client.middleware)
+	return client
 }
 
 func (client *Client) Request(method string, path string, headers map[string]string, body io.Reader, out interface{}) error {
-	_, _, _, err := client.request(method, path, headers, body, out)
-	return err
+	return client.RequestCtx(context.Background(), method, path, headers, body, out)
 }
 
-func (client *Client) RequestWithParams(method string, path string, headers map[string]string, params map[string]interface{}, out interface{}) error {
-	_, _, _, err := client.requestWithParams(method, path, headers, params, out)
+// RequestCtx is the context-aware version of Request.
+func (client *Client) RequestCtx(ctx context.Context, method string, path string, headers map[string]string, body io.Reader, out interface{}) error {
+	_, err := client.request(ctx, method, path, headers, body, out)
 	return err
 }
 
-func (client *Client) requestWithParamsAndStatusCode(method string, path string, headers map[string]string, params map[string]interface{}, out interface{}) (int, error) {
-	statusCode, _, _, err := client.requestWithParams(method, path, headers, params, out)
-	return statusCode, err
+func (client *Client) RequestWithParams(method string, path string, headers map[string]string, params map[string]interface{}, out interface{}) error {
+	return client.RequestWithParamsCtx(context.Background(), method, path, headers, params, out)
 }
 
-func (client *Client) requestWithParamsAndRemainingLimit(method string, path string, headers map[string]string, params map[string]interface{}, out interface{}) (int, int, error) {
-	_, remaining, limit, err := client.requestWithParams(method, path, headers, params, out)
-	return remaining, limit, err
+// RequestWithParamsCtx is the context-aware version of RequestWithParams.
+func (client *Client) RequestWithParamsCtx(ctx context.Context, method string, path string, headers map[string]string, params map[string]interface{}, out interface{}) error {
+	_, err := client.requestWithParams(ctx, method, path, headers, params, out)
+	return err
 }
 
-func (client *Client) request(method string, path string, headers map[string]string, body io.Reader, out interface{}) (int, int, int, error) {
-	// for some reason `httpClient: &http.Client{Timeout: 5 * time.Minute}` doesn't seem to work, so trying with this extra line
-	ctx, cncl := context.WithTimeout(context.Background(), time.Minute*5)
-	defer cncl()
+func (client *Client) request(ctx context.Context, method string, path string, headers map[string]string, body io.Reader, out interface{}) (*Response, error) {
+	path, err := applyFields(ctx, method, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// buffer the body so every attempt (token refresh, rate limit, retry) can replay it
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		bodyBytes, err = ioutil.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	params, _ := ctx.Value(requestParamsKey{}).(map[string]interface{})
+	callCtx := withRequestInfo(ctx, &RequestInfo{Kind: RequestKindAPI, Method: method, Path: path, Params: params})
 
-	req, err := http.NewRequestWithContext(ctx, method, "https://api.podio.com"+path, body)
+	start := time.Now()
+	resp, respBody, err := client.send(callCtx, method, path, headers, bodyBytes)
 	if err != nil {
-		return 0, 0, 0, err
+		client.observe(method, path, start, nil, err)
+		return nil, err
 	}
 
-	for k, v := range headers {
-		req.Header.Add(k, v)
+	response := newResponse(resp, respBody)
+	fillCapturedResponses(ctx, response)
+
+	if !(200 <= resp.StatusCode && resp.StatusCode < 300) {
+		err := newError(resp, respBody)
+		client.observe(method, path, start, response, err)
+		return response, err
 	}
+	client.observe(method, path, start, response, nil)
 
-	req.Header.Add("Authorization", "OAuth2 "+client.authToken.AccessToken)
-	resp, err := client.httpClient.Do(req)
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return response, err
+		}
+		return response, client.checkDecoded(ctx, out)
+	}
+
+	return response, nil
+}
+
+func (client *Client) observe(method string, path string, start time.Time, response *Response, err error) {
+	if client.metrics == nil {
+		return
+	}
+
+	metric := RequestMetric{
+		Method:   method,
+		Route:    RouteTemplate(path),
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if response != nil {
+		metric.StatusCode = response.StatusCode
+		metric.RateLimit = response.RateLimit
+		metric.RateLimitRemaining = response.RateLimitRemaining
+	}
+	client.metrics.ObserveRequest(metric)
+}
+
+// send waits for the rate limiter before every attempt, retries rate limited requests
+// and retries transient failures according to the retry policy
+func (client *Client) send(ctx context.Context, method string, path string, headers map[string]string, body []byte) (*http.Response, []byte, error) {
+	route := method + " " + RouteTemplate(path)
+	rateLimitRetries := 0
+	for attempt := 1; ; attempt++ {
+		if client.rateLimiter != nil {
+			if err := client.rateLimiter.WaitRoute(ctx, route); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		resp, respBody, err := client.sendWithToken(ctx, method, path, headers, body)
+		if err == nil && client.rateLimiter != nil {
+			client.rateLimiter.UpdateRoute(route, resp)
+			if isRateLimited(resp.StatusCode) && rateLimitRetries < client.rateLimiter.MaxRetries {
+				rateLimitRetries++
+				retryAfter := parseRetryAfter(resp.Header)
+				client.log().WarnContext(ctx, "podio rate limit hit, waiting", "method", method, "path", path, "retry_after", retryAfter)
+				client.rateLimiter.BlockRoute(route, retryAfter)
+				attempt--
+				continue
+			}
+		}
+
+		delay, retry := client.retryPolicy.backoff(ctx, method, path, attempt, resp, err)
+		if !retry {
+			return resp, respBody, err
+		}
+		attrs := []any{"method", method, "path", path, "attempt", attempt, "delay", delay}
+		if err != nil {
+			attrs = append(attrs, "error", err)
+		} else {
+			attrs = append(attrs, "status", resp.StatusCode)
+		}
+		client.log().InfoContext(ctx, "retrying podio request", attrs...)
+		if err := sleep(ctx, delay); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// sendWithToken authorizes the request and refreshes the token once when Podio rejects it
+func (client *Client) sendWithToken(ctx context.Context, method string, path string, headers map[string]string, body []byte) (*http.Response, []byte, error) {
+	token, err := client.currentToken(ctx)
 	if err != nil {
-		return 0, 0, 0, err
+		return nil, nil, err
 	}
-	defer resp.Body.Close()
 
-	respBody, err := ioutil.ReadAll(resp.Body)
+	resp, respBody, err := client.do(ctx, method, path, headers, body, token)
 	if err != nil {
-		return 0, 0, 0, err
+		return nil, nil, err
 	}
 
-	if !(200 <= resp.StatusCode && resp.StatusCode < 300) {
-		podioErr := &Error{}
-		err := json.Unmarshal(respBody, podioErr)
+	if client.canRefresh() && token.RefreshToken != "" && isInvalidTokenResponse(resp.StatusCode, respBody) {
+		token, err = client.refreshToken(ctx, token)
 		if err != nil {
-			return 0, 0, 0, errors.New(string(respBody))
+			return nil, nil, err
 		}
-		return 0, 0, 0, podioErr
+		return client.do(ctx, method, path, headers, body, token)
 	}
+	return resp, respBody, nil
+}
 
-	limitString := resp.Header.Get("X-Rate-Limit-Limit")
-	remainingString := resp.Header.Get("X-Rate-Limit-Remaining")
-	limit, _ := strconv.Atoi(limitString)
-	remaining, _ := strconv.Atoi(remainingString)
+// do sends a single API request and reads the complete response body
+func (client *Client) do(ctx context.Context, method string, path string, headers map[string]string, body []byte, token *AuthToken) (*http.Response, []byte, error) {
+	// for some reason `httpClient: &http.Client{Timeout: 5 * time.Minute}` doesn't seem to work, so trying with this extra line.
+	// The timeout caps each attempt, waiting for the rate limiter or a retry only ends with ctx.
+	ctx, cancel := context.WithTimeout(ctx, attemptTimeout)
+	defer cancel()
 
-	if out != nil {
-		err := json.Unmarshal(respBody, out)
-		return 0, remaining, limit, err
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
 	}
 
-	return resp.StatusCode, remaining, limit, nil
+	req, err := http.NewRequestWithContext(ctx, method, client.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for k, v := range headers {
+		req.Header.Add(k, v)
+	}
+	client.setUserAgent(req)
+
+	req.Header.Add("Authorization", "OAuth2 "+token.AccessToken)
+	if info := RequestInfoFromContext(ctx); info != nil {
+		info.Attempt++
+	}
+	resp, err := client.doer.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, respBody, nil
 }
 
-func (client *Client) requestWithParams(method string, path string, headers map[string]string, params map[string]interface{}, out interface{}) (int, int, int, error) {
+func (client *Client) requestWithParams(ctx context.Context, method string, path string, headers map[string]string, params map[string]interface{}, out interface{}) (*Response, error) {
 	var body io.Reader
 
 	if method == "GET" {
 		pathURL, err := url.Parse(path)
 		if err != nil {
-			return 0, 0, 0, err
+			return nil, err
 		}
 		query := pathURL.Query()
 		for key, value := range params {
@@ -129,13 +295,19 @@ func (client *Client) requestWithParams(method string, path string, headers map[
 	} else {
 		buf, err := json.Marshal(params)
 		if err != nil {
-			return 0, 0, 0, err
+			return nil, err
 		}
 		body = bytes.NewReader(buf)
 	}
 
-	respCode, rateLimitRemaining, rateLimit, err := client.request(method, path, headers, body, out)
-	return respCode, rateLimitRemaining, rateLimit, err
+	ctx = context.WithValue(ctx, requestParamsKey{}, params)
+	return client.request(ctx, method, path, headers, body, out)
+}
+
+func (client *Client) setUserAgent(req *http.Request) {
+	if client.userAgent != "" {
+		req.Header.Set("User-Agent", client.userAgent)
+	}
 }
 
 func (client *Client) AddOptionsToPath(path string, options map[string]interface{}) (string, error) {
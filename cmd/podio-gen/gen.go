@@ -0,0 +1,409 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/andreas/podio-go"
+)
+
+// fieldSettings are the parts of the field settings the generator uses
+type fieldSettings struct {
+	Options []struct {
+		Id     int    `json:"id"`
+		Text   string `json:"text"`
+		Status string `json:"status"`
+	} `json:"options"`
+	Multiple   bool   `json:"multiple"`
+	ReturnType string `json:"return_type"`
+}
+
+type genOption struct {
+	name string
+	id   int
+	text string
+}
+
+type genField struct {
+	field      podio.AppField
+	name       string
+	goType     string
+	optionType string // the type of the options of a category or question field
+	options    []genOption
+	multiple   bool
+	filter     string // the ItemFilter method filtering the field, if any
+}
+
+type genApp struct {
+	app      *podio.App
+	typeName string
+	fields   []genField
+	skipped  []podio.AppField
+}
+
+// generate writes the Go source for apps in package pkg
+func generate(pkg string, apps []*podio.App) ([]byte, error) {
+	var genApps []*genApp
+	usesTime := false
+	for _, app := range apps {
+		g, err := newGenApp(app)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range g.fields {
+			usesTime = usesTime || strings.Contains(f.goType, "time.")
+		}
+		genApps = append(genApps, g)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by podio-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import (\n\t\"context\"\n")
+	if usesTime {
+		fmt.Fprintf(&b, "\t\"time\"\n")
+	}
+	fmt.Fprintf(&b, "\n\t\"github.com/andreas/podio-go\"\n)\n")
+	for _, g := range genApps {
+		g.write(&b)
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, b.Bytes())
+	}
+	return src, nil
+}
+
+func newGenApp(app *podio.App) (*genApp, error) {
+	name := app.Config.ItemName
+	if name == "" {
+		name = app.Config.Name
+	}
+	if name == "" {
+		name = app.Name
+	}
+	g := &genApp{app: app, typeName: goName(name, "App")}
+
+	used := map[string]bool{"ItemId": true}
+	for _, field := range app.Fields {
+		if field.Status == "deleted" {
+			continue
+		}
+		f, ok, err := g.newGenField(field)
+		if err != nil {
+			return nil, fmt.Errorf("app %d field %s: %w", app.Id, field.ExternalId, err)
+		}
+		if !ok {
+			g.skipped = append(g.skipped, field)
+			continue
+		}
+		if used[f.name] {
+			f.name = fmt.Sprintf("%s%d", f.name, field.Id)
+		}
+		used[f.name] = true
+		if f.options != nil {
+			f.optionType = g.typeName + f.name
+			f.goType = "*" + f.optionType
+			if f.multiple {
+				f.goType = "[]" + f.optionType
+			}
+		}
+		g.fields = append(g.fields, f)
+	}
+	return g, nil
+}
+
+func (g *genApp) newGenField(field podio.AppField) (f genField, ok bool, err error) {
+	var settings fieldSettings
+	if field.Config.Settings != nil {
+		if err = json.Unmarshal(*field.Config.Settings, &settings); err != nil {
+			return
+		}
+	}
+
+	key := field.ExternalId
+	if key == "" {
+		key = field.Label
+	}
+	f = genField{field: field, name: goName(key, fmt.Sprintf("Field%d", field.Id))}
+
+	// single values are pointers even for required fields, so Update leaves the fields it
+	// wasn't given unchanged instead of clearing them
+	switch field.Type {
+	case "text", "location":
+		f.goType = "*string"
+	case "number":
+		f.goType, f.filter = "*float64", "Between"
+	case "money":
+		f.goType, f.filter = "*podio.MoneyValue", "Between"
+	case "progress":
+		f.goType, f.filter = "*int", "Between"
+	case "duration":
+		f.goType, f.filter = "*time.Duration", "Between"
+	case "date":
+		// podio.Date keeps whether the date is all-day
+		f.goType, f.filter = "*podio.Date", "Dates"
+	case "category", "question":
+		f.filter, f.multiple = "Categories", settings.Multiple
+		f.options = optionsOf(settings)
+	case "app":
+		f.goType, f.filter = "[]podio.ItemID", "AppRefs"
+	case "contact":
+		f.goType, f.filter = "[]podio.ProfileID", "Contacts"
+	case "image":
+		f.goType = "[]podio.FileID"
+	case "embed":
+		f.goType = "[]podio.EmbedValue"
+	case "email":
+		f.goType = "[]podio.EmailValue"
+	case "phone":
+		f.goType = "[]podio.PhoneValue"
+	case "calculation":
+		switch settings.ReturnType {
+		case "number":
+			f.goType = "*float64"
+		case "text":
+			f.goType = "*string"
+		case "date":
+			f.goType = "*podio.Date"
+		case "money":
+			f.goType = "*podio.MoneyValue"
+		case "duration":
+			f.goType = "*time.Duration"
+		default:
+			return f, false, nil
+		}
+	default:
+		return f, false, nil
+	}
+	return f, true, nil
+}
+
+// optionsOf lists the active options of a category or question field with unique constant names
+func optionsOf(settings fieldSettings) []genOption {
+	options := []genOption{}
+	used := map[string]bool{}
+	for _, option := range settings.Options {
+		if option.Status != "" && option.Status != "active" {
+			continue
+		}
+		name := goName(option.Text, fmt.Sprintf("Option%d", option.Id))
+		if used[name] {
+			name = fmt.Sprintf("%s%d", name, option.Id)
+		}
+		used[name] = true
+		options = append(options, genOption{name: name, id: option.Id, text: option.Text})
+	}
+	return options
+}
+
+func (g *genApp) write(b *bytes.Buffer) {
+	app, name := g.app, g.typeName
+	plural := goName(app.Config.Name, name+"s")
+	if app.Config.Name == "" {
+		plural = goName(app.Name, name+"s")
+	}
+	if plural == name {
+		plural = name + "Items"
+	}
+
+	fmt.Fprintf(b, "\n// %sAppID is the id of the %s app\n", name, appName(app))
+	fmt.Fprintf(b, "const %sAppID podio.AppID = %d\n", name, app.Id)
+
+	fmt.Fprintf(b, "\n// %s is an item of the %s app\n", name, appName(app))
+	fmt.Fprintf(b, "type %s struct {\n", name)
+	fmt.Fprintf(b, "\tItemId podio.ItemID\n\n")
+	for _, f := range g.fields {
+		if f.field.Label != "" && f.field.Label != f.name {
+			fmt.Fprintf(b, "\t// %s\n", oneLine(f.field.Label))
+		}
+		fmt.Fprintf(b, "\t%s %s `podio:\"external_id=%s,type=%s\"`\n", f.name, f.goType, f.field.ExternalId, f.field.Type)
+	}
+	for _, field := range g.skipped {
+		fmt.Fprintf(b, "\t// %s (%s) has the unsupported type %s\n", field.ExternalId, oneLine(field.Label), field.Type)
+	}
+	fmt.Fprintf(b, "}\n")
+
+	for _, f := range g.fields {
+		if f.optionType == "" {
+			continue
+		}
+		fmt.Fprintf(b, "\n// %s is an option of the %s field\n", f.optionType, oneLine(f.field.Label))
+		fmt.Fprintf(b, "type %s int\n", f.optionType)
+		if len(f.options) == 0 {
+			continue
+		}
+		fmt.Fprintf(b, "\nconst (\n")
+		for _, option := range f.options {
+			fmt.Fprintf(b, "\t%s%s %s = %d // %s\n", f.optionType, option.name, f.optionType, option.id, oneLine(option.text))
+		}
+		fmt.Fprintf(b, ")\n")
+	}
+
+	fmt.Fprintf(b, `
+// Get%[1]s gets an item of the %[2]s app
+func Get%[1]s(ctx context.Context, items podio.ItemService, itemId podio.ItemID) (*%[1]s, error) {
+	item, err := items.GetItem(ctx, itemId)
+	if err != nil {
+		return nil, err
+	}
+	return Unmarshal%[1]s(item)
+}
+
+// Unmarshal%[1]s maps the fields of an item of the %[2]s app
+func Unmarshal%[1]s(item podio.FieldGetter) (*%[1]s, error) {
+	v := &%[1]s{}
+	if err := podio.UnmarshalItem(item, v); err != nil {
+		return nil, err
+	}
+	switch item := item.(type) {
+	case *podio.Item:
+		v.ItemId = item.Id
+	case *podio.ItemSimple:
+		v.ItemId = item.Id
+	}
+	return v, nil
+}
+
+// Create%[1]s creates an item in the %[2]s app, nil fields are left out
+func Create%[1]s(ctx context.Context, items podio.ItemService, v *%[1]s) (podio.ItemID, error) {
+	fields, err := podio.MarshalItemValues(v)
+	if err != nil {
+		return 0, err
+	}
+	return items.CreateItem(ctx, %[1]sAppID, "", fields)
+}
+
+// Update%[1]s updates the item v.ItemId of the %[2]s app, nil fields are left unchanged
+func Update%[1]s(ctx context.Context, items podio.ItemService, v *%[1]s) error {
+	fields, err := podio.MarshalItemValues(v)
+	if err != nil {
+		return err
+	}
+	return items.UpdateItem(ctx, v.ItemId, fields)
+}
+
+// %[1]sFilter filters the items of the %[2]s app
+type %[1]sFilter struct {
+	*podio.ItemFilter
+}
+
+// New%[1]sFilter returns an empty filter for the %[2]s app
+func New%[1]sFilter() %[1]sFilter {
+	return %[1]sFilter{podio.NewItemFilter()}
+}
+
+// Filter%[3]s returns the items of the %[2]s app matching filter
+func Filter%[3]s(ctx context.Context, items podio.ItemService, filter %[1]sFilter) ([]*%[1]s, error) {
+	params, err := filter.Params()
+	if err != nil {
+		return nil, err
+	}
+	var out []*%[1]s
+	for item, err := range items.IterateItemsSimple(ctx, %[1]sAppID, params) {
+		if err != nil {
+			return nil, err
+		}
+		v, err := Unmarshal%[1]s(item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+`, name, appName(app), plural)
+
+	for _, f := range g.fields {
+		g.writeFilter(b, f)
+	}
+}
+
+func (g *genApp) writeFilter(b *bytes.Buffer, f genField) {
+	filter, key, label := g.typeName+"Filter", strconv.Quote(f.field.ExternalId), oneLine(f.field.Label)
+	method := f.name
+	if filterMethods[method] {
+		method += "Field"
+	}
+	switch f.filter {
+	case "Between":
+		fmt.Fprintf(b, "\n// %sBetween keeps items with %s from from to to\n", f.name, label)
+		fmt.Fprintf(b, "func (f %s) %sBetween(from, to float64) %s {\n\tf.Between(%s, from, to)\n\treturn f\n}\n", filter, f.name, filter, key)
+	case "Dates":
+		fmt.Fprintf(b, "\n// %sBetween keeps items with %s from from to to, see podio.ItemFilter.Dates\n", f.name, label)
+		fmt.Fprintf(b, "func (f %s) %sBetween(from, to string) %s {\n\tf.Dates(%s, from, to)\n\treturn f\n}\n", filter, f.name, filter, key)
+	case "Categories":
+		fmt.Fprintf(b, "\n// %s keeps items with any of the %s options\n", method, label)
+		fmt.Fprintf(b, "func (f %s) %s(options ...%s) %s {\n", filter, method, f.optionType, filter)
+		fmt.Fprintf(b, "\tids := make([]int, len(options))\n\tfor i, option := range options {\n\t\tids[i] = int(option)\n\t}\n")
+		fmt.Fprintf(b, "\tf.Categories(%s, ids...)\n\treturn f\n}\n", key)
+	case "AppRefs":
+		fmt.Fprintf(b, "\n// %s keeps items referencing any of itemIds in %s\n", method, label)
+		fmt.Fprintf(b, "func (f %s) %s(itemIds ...podio.ItemID) %s {\n\tf.AppRefs(%s, itemIds...)\n\treturn f\n}\n", filter, method, filter, key)
+	case "Contacts":
+		fmt.Fprintf(b, "\n// %s keeps items with any of profileIds in %s\n", method, label)
+		fmt.Fprintf(b, "func (f %s) %s(profileIds ...podio.ProfileID) %s {\n\tf.Contacts(%s, profileIds...)\n\treturn f\n}\n", filter, method, filter, key)
+	}
+}
+
+// filterMethods are the methods of podio.ItemFilter a generated filter method must not shadow
+var filterMethods = map[string]bool{
+	"ForApp": true, "SortBy": true, "Limit": true, "Offset": true, "Remember": true, "Categories": true,
+	"Contacts": true, "AppRefs": true, "Between": true, "AtLeast": true, "AtMost": true, "Dates": true,
+	"DatesBetween": true, "AppItemIds": true, "CreatedBy": true, "CreatedVia": true, "CreatedOn": true,
+	"LastEditBy": true, "LastEditVia": true, "LastEditOn": true, "Tags": true, "Key": true,
+	"Validate": true, "Params": true, "ItemFilter": true,
+}
+
+func appName(app *podio.App) string {
+	if app.Config.Name != "" {
+		return oneLine(app.Config.Name)
+	}
+	return oneLine(app.Name)
+}
+
+// goName turns s into an exported Go identifier, e.g. "due-date" into DueDate, or returns fallback
+func goName(s, fallback string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if r > unicode.MaxASCII {
+				upper = true
+				continue
+			}
+			if upper {
+				r = unicode.ToUpper(r)
+			}
+			b.WriteRune(r)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+	name := b.String()
+	if name == "" {
+		return fallback
+	}
+	if unicode.IsDigit(rune(name[0])) {
+		name = "N" + name
+	}
+	return name
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// sortApps orders apps by id so the output does not depend on the order of the inputs
+func sortApps(apps []*podio.App) {
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Id < apps[j].Id })
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"testing"
+
+	"github.com/andreas/podio-go"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestGenerate(t *testing.T) {
+	r := require.New(t)
+
+	app, err := readApp("testdata/projects.json")
+	r.NoError(err)
+
+	src, err := generate("projects", []*podio.App{app})
+	r.NoError(err)
+
+	// the generated code must compile against the current podio package
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "projects.go", src, 0)
+	r.NoError(err)
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = conf.Check("projects", fset, []*ast.File{file}, nil)
+	r.NoError(err)
+
+	// regenerate the golden file with go test -update
+	golden := "testdata/projects.go.golden"
+	if *update {
+		r.NoError(os.WriteFile(golden, src, 0644))
+	}
+	want, err := os.ReadFile(golden)
+	r.NoError(err)
+	r.Equal(string(want), string(src))
+}
+
+func TestGoName(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("DueDate", goName("due-date", "X"))
+	r.Equal("N2024Budget", goName("2024 budget", "X"))
+	r.Equal("X", goName("", "X"))
+}
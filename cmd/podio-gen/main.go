@@ -0,0 +1,141 @@
+// Command podio-gen writes Go types for Podio apps, so a change of an app shows up as a compile error.
+//
+// For every app it writes a struct with a typed field per app field, constants for the options of
+// category fields and helpers to get, create, update and filter the items of the app:
+//
+//	podio-gen -package projects -out projects/apps.go -app 123 -app 456
+//	podio-gen -package projects -out projects/apps.go app.json
+//
+// Apps given by id are fetched with GetApp, authenticating with the client credentials in
+// PODIO_CLIENT_ID and PODIO_CLIENT_SECRET and either PODIO_USERNAME and PODIO_PASSWORD or,
+// for a single app, its token in PODIO_APP_TOKEN. Other arguments are files with the JSON
+// of an app as returned by GetApp, which -save writes for the fetched apps.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/andreas/podio-go"
+)
+
+type appIds []int64
+
+func (ids *appIds) String() string {
+	return fmt.Sprint(*ids)
+}
+
+func (ids *appIds) Set(s string) error {
+	for _, part := range strings.Split(s, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			return err
+		}
+		*ids = append(*ids, id)
+	}
+	return nil
+}
+
+func main() {
+	var ids appIds
+	flag.Var(&ids, "app", "id of an app to fetch, can be repeated or comma separated")
+	pkg := flag.String("package", "apps", "package of the generated code")
+	out := flag.String("out", "", "file to write the code to, stdout if empty")
+	save := flag.String("save", "", "directory to save the JSON of fetched apps to")
+	flag.Parse()
+
+	var apps []*podio.App
+	for _, path := range flag.Args() {
+		app, err := readApp(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		apps = append(apps, app)
+	}
+	if len(ids) > 0 {
+		fetched, err := fetchApps(context.Background(), ids)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if *save != "" {
+			for _, app := range fetched {
+				if err := saveApp(*save, app); err != nil {
+					log.Fatal(err)
+				}
+			}
+		}
+		apps = append(apps, fetched...)
+	}
+	if len(apps) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	sortApps(apps)
+
+	src, err := generate(*pkg, apps)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *out == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readApp(path string) (*podio.App, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	app := &podio.App{}
+	if err := json.Unmarshal(buf, app); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return app, nil
+}
+
+func saveApp(dir string, app *podio.App) error {
+	buf, err := json.MarshalIndent(app, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, fmt.Sprintf("app-%d.json", app.Id)), buf, 0644)
+}
+
+func fetchApps(ctx context.Context, ids appIds) ([]*podio.App, error) {
+	clientId, clientSecret := os.Getenv("PODIO_CLIENT_ID"), os.Getenv("PODIO_CLIENT_SECRET")
+	var token *podio.AuthToken
+	var err error
+	switch {
+	case os.Getenv("PODIO_USERNAME") != "":
+		token, err = podio.AuthWithUserCredentialsCtx(ctx, clientId, clientSecret, os.Getenv("PODIO_USERNAME"), os.Getenv("PODIO_PASSWORD"))
+	case os.Getenv("PODIO_APP_TOKEN") != "" && len(ids) == 1:
+		token, err = podio.AuthWithAppCredentialsCtx(ctx, clientId, clientSecret, ids[0], os.Getenv("PODIO_APP_TOKEN"))
+	default:
+		return nil, fmt.Errorf("set PODIO_USERNAME and PODIO_PASSWORD, or PODIO_APP_TOKEN for a single app")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	client := podio.NewClient(token)
+	var apps []*podio.App
+	for _, id := range ids {
+		app, err := client.GetAppCtx(ctx, podio.AppID(id))
+		if err != nil {
+			return nil, fmt.Errorf("app %d: %w", id, err)
+		}
+		apps = append(apps, app)
+	}
+	return apps, nil
+}
@@ -1,6 +1,9 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Comment is a comment on an object in podio.
 // The object to which this comment is associated is described in this Reference.
@@ -26,6 +29,11 @@ type Comment struct {
 // text is the actual comment value.
 // Additional parameters can be set in the params map.
 func (client *Client) Comment(refType string, refId int64, text string, params map[string]interface{}) (*Comment, error) {
+	return client.CommentCtx(context.Background(), refType, refId, text, params)
+}
+
+// CommentCtx is the context-aware version of Comment.
+func (client *Client) CommentCtx(ctx context.Context, refType string, refId int64, text string, params map[string]interface{}) (*Comment, error) {
 	path := fmt.Sprintf("/comment/%s/%d/", refType, refId)
 	if params == nil {
 		params = map[string]interface{}{}
@@ -33,26 +41,36 @@ func (client *Client) Comment(refType string, refId int64, text string, params m
 	params["value"] = text
 
 	comment := &Comment{}
-	err := client.RequestWithParams("POST", path, nil, params, comment)
+	err := client.RequestWithParamsCtx(ctx, "POST", path, nil, params, comment)
 	return comment, err
 }
 
 // UpdateComment updates a comment in Podio
 func (client *Client) UpdateComment(commentID int64, text string, params map[string]interface{}) error {
+	return client.UpdateCommentCtx(context.Background(), commentID, text, params)
+}
+
+// UpdateCommentCtx is the context-aware version of UpdateComment.
+func (client *Client) UpdateCommentCtx(ctx context.Context, commentID int64, text string, params map[string]interface{}) error {
 	path := fmt.Sprintf("/comment/%d/", commentID)
 	if params == nil {
 		params = map[string]interface{}{}
 	}
 	params["value"] = text
 
-	err := client.RequestWithParams("PUT", path, nil, params, nil)
+	err := client.RequestWithParamsCtx(ctx, "PUT", path, nil, params, nil)
 	return err
 }
 
 // DeleteComment deletes a comment in Podio
 func (client *Client) DeleteComment(commentID int64) error {
+	return client.DeleteCommentCtx(context.Background(), commentID)
+}
+
+// DeleteCommentCtx is the context-aware version of DeleteComment.
+func (client *Client) DeleteCommentCtx(ctx context.Context, commentID int64) error {
 	path := fmt.Sprintf("/comment/%d/", commentID)
-	return client.Request("DELETE", path, nil, nil, nil)
+	return client.RequestCtx(ctx, "DELETE", path, nil, nil, nil)
 }
 
 // https://developers.podio.com/doc/comments/get-comments-on-object-22371
@@ -61,14 +79,33 @@ func (client *Client) DeleteComment(commentID int64) error {
 // refType is the type of the podio object. For legal type values see
 // refId is the podio id of the podio object.
 func (client *Client) GetComments(refType string, refId int64) (comments []*Comment, err error) {
+	return client.GetCommentsCtx(context.Background(), refType, refId)
+}
+
+// GetCommentsCtx is the context-aware version of GetComments.
+func (client *Client) GetCommentsCtx(ctx context.Context, refType string, refId int64) (comments []*Comment, err error) {
 	path := fmt.Sprintf("/comment/%s/%d/", refType, refId)
-	err = client.Request("GET", path, nil, nil, &comments)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &comments)
 	return
 }
 
+// PaginateComments pages through the comments of GetComments.
+func (client *Client) PaginateComments(refType string, refId int64, opts ...IterateOption) *Paginator[*Comment] {
+	return NewPaginator(func(ctx context.Context, limit, offset int) (comments []*Comment, err error) {
+		path := fmt.Sprintf("/comment/%s/%d/", refType, refId)
+		err = client.RequestWithParamsCtx(ctx, "GET", path, nil, pageParams(nil, limit, offset), &comments)
+		return
+	}, maxCommentLimit, opts...)
+}
+
 // https://developers.podio.com/doc/comments/get-a-comment-22345
 func (client *Client) GetComment(commentId int64) (comment *Comment, err error) {
+	return client.GetCommentCtx(context.Background(), commentId)
+}
+
+// GetCommentCtx is the context-aware version of GetComment.
+func (client *Client) GetCommentCtx(ctx context.Context, commentId int64) (comment *Comment, err error) {
 	path := fmt.Sprintf("/comment/%d/", commentId)
-	err = client.Request("GET", path, nil, nil, &comment)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &comment)
 	return
 }
@@ -1,20 +1,28 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Contact describes a Podio contact object
 type Contact struct {
-	UserId     int      `json:"user_id"`
-	SpaceId    int      `json:"space_id"`
-	Type       string   `json:"type"`
-	Image      File     `json:"image"`
-	ProfileId  int      `json:"profile_id"`
-	OrgId      int      `json:"org_id"`
-	Link       string   `json:"link"`
-	Avatar     int      `json:"avatar"`
-	LastSeenOn *Time    `json:"last_seen_on"`
-	Name       string   `json:"name"`
-	Emails     []string `json:"mail"`
+	UserId     UserID    `json:"user_id"`
+	SpaceId    SpaceID   `json:"space_id"`
+	Type       string    `json:"type"`
+	Image      File      `json:"image,omitzero"`
+	ProfileId  ProfileID `json:"profile_id"`
+	OrgId      int       `json:"org_id"`
+	Link       string    `json:"link"`
+	Avatar     int       `json:"avatar"`
+	LastSeenOn *Time     `json:"last_seen_on"`
+	Name       string    `json:"name"`
+	Emails     []string  `json:"mail"`
+}
+
+func (contact Contact) MarshalJSON() ([]byte, error) {
+	type plain Contact
+	return marshalOmitZero(plain(contact))
 }
 
 const (
@@ -23,6 +31,11 @@ const (
 
 // https://developers.podio.com/doc/contacts/get-contacts-22400
 func (client *Client) GetContacts(limit, offset int) (contacts []Contact, err error) {
+	return client.GetContactsCtx(context.Background(), limit, offset)
+}
+
+// GetContactsCtx is the context-aware version of GetContacts.
+func (client *Client) GetContactsCtx(ctx context.Context, limit, offset int) (contacts []Contact, err error) {
 	if limit == 0 {
 		limit = maxPullContacts
 	}
@@ -31,13 +44,25 @@ func (client *Client) GetContacts(limit, offset int) (contacts []Contact, err er
 		"offset": offset,
 		"order":  "overall",
 	}
-	err = client.RequestWithParams("GET", "/contact/", nil, params, &contacts)
+	err = client.RequestWithParamsCtx(ctx, "GET", "/contact/", nil, params, &contacts)
 	return
 }
 
+// PaginateContacts pages through the contacts of GetContacts.
+func (client *Client) PaginateContacts(opts ...IterateOption) *Paginator[Contact] {
+	return NewPaginator(func(ctx context.Context, limit, offset int) ([]Contact, error) {
+		return client.GetContactsCtx(ctx, limit, offset)
+	}, maxPullContacts, opts...)
+}
+
 // https://developers.podio.com/doc/contacts/get-user-contact-60514
 func (client *Client) GetContact(userId int64) (contact Contact, err error) {
+	return client.GetContactCtx(context.Background(), UserID(userId))
+}
+
+// GetContactCtx is the context-aware version of GetContact.
+func (client *Client) GetContactCtx(ctx context.Context, userId UserID) (contact Contact, err error) {
 	path := fmt.Sprintf("/contact/user/%d", userId)
-	err = client.Request("GET", path, nil, nil, &contact)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &contact)
 	return
 }
@@ -1,6 +1,9 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Conversation struct {
 	Id           int64                     `json:"conversation_id"`
@@ -22,21 +25,31 @@ type ConversationMessage struct {
 }
 
 type ConversationParticipant struct {
-	Id     int64  `json:"user_id"`
+	Id     UserID `json:"user_id"`
 	Avatar int    `json:"avatar"`
 	Name   string `json:"name"`
 }
 
 // https://developers.podio.com/doc/conversations/create-conversation-v2-37301474
 func (client *Client) CreateConversation(params map[string]interface{}) (c Conversation, err error) {
-	err = client.RequestWithParams("POST", "/conversation/v2/", nil, params, &c)
+	return client.CreateConversationCtx(context.Background(), params)
+}
+
+// CreateConversationCtx is the context-aware version of CreateConversation.
+func (client *Client) CreateConversationCtx(ctx context.Context, params map[string]interface{}) (c Conversation, err error) {
+	err = client.RequestWithParamsCtx(ctx, "POST", "/conversation/v2/", nil, params, &c)
 	return
 }
 
 // https://developers.podio.com/doc/conversations/add-participants-v2-37282400
 func (client *Client) ConversationAddParticipants(conversationId int64, participants []interface{}) (err error) {
+	return client.ConversationAddParticipantsCtx(context.Background(), conversationId, participants)
+}
+
+// ConversationAddParticipantsCtx is the context-aware version of ConversationAddParticipants.
+func (client *Client) ConversationAddParticipantsCtx(ctx context.Context, conversationId int64, participants []interface{}) (err error) {
 	path := fmt.Sprintf("/conversation/%d/participant/v2/", conversationId)
 	params := map[string]interface{}{"participants": participants}
-	err = client.RequestWithParams("POST", path, nil, params, nil)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, nil)
 	return
 }
@@ -0,0 +1,110 @@
+package podio
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	podioDateLayout = "2006-01-02"
+	podioTimeLayout = "15:04:05"
+)
+
+// Date is a DateValue resolved into times. The times of a timed date are instants in the
+// location given to DateValue.In. An all-day date is a range of calendar days: Start and End
+// are midnight of the first and last day in that location, whatever its offset to UTC.
+type Date struct {
+	Start  time.Time
+	End    time.Time // zero when the date has no end
+	AllDay bool
+}
+
+// HasEnd reports whether the date is a range
+func (d Date) HasEnd() bool {
+	return !d.End.IsZero()
+}
+
+// AllDay reports whether the value is a date without a time of day
+func (v DateValue) AllDay() bool {
+	if v.StartTime != nil || v.StartTimeUTC != nil {
+		return false
+	}
+	if v.StartDate != nil || v.StartDateUTC != nil {
+		return true
+	}
+	return v.StartUTC != nil && len(*v.StartUTC) == len(podioDateLayout)
+}
+
+// In resolves the value into times in loc, e.g. the location of User.Timezone (see User.Location).
+// Timed values are read from the UTC parts, which don't depend on the user fetching the item,
+// and fall back to Start and End for values without them.
+func (v DateValue) In(loc *time.Location) (date Date, err error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	date.AllDay = v.AllDay()
+	if date.AllDay {
+		if date.Start, err = parseDay(loc, v.StartDate, v.StartDateUTC, v.StartUTC, v.Start); err != nil {
+			return
+		}
+		date.End, err = parseDay(loc, v.EndDate, v.EndDateUTC, v.EndUTC, v.End)
+		return
+	}
+	if date.Start, err = parseInstant(v.StartUTC, v.StartDateUTC, v.StartTimeUTC); err != nil {
+		return
+	}
+	if date.End, err = parseInstant(v.EndUTC, v.EndDateUTC, v.EndTimeUTC); err != nil {
+		return
+	}
+	if date.Start.IsZero() && v.Start != nil {
+		date.Start = v.Start.Time
+	}
+	if date.End.IsZero() && v.End != nil {
+		date.End = v.End.Time
+	}
+	date.Start, date.End = inLocation(date.Start, loc), inLocation(date.End, loc)
+	return
+}
+
+// InTimezone is In for the IANA time zone name timezone, such as User.Timezone
+func (v DateValue) InTimezone(timezone string) (Date, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return Date{}, err
+	}
+	return v.In(loc)
+}
+
+// parseDay returns midnight in loc of the first day given by date, dateUTC, utc or local
+func parseDay(loc *time.Location, date, dateUTC, utc *string, local *Time) (time.Time, error) {
+	for _, s := range []*string{date, dateUTC, utc} {
+		if s != nil && len(*s) >= len(podioDateLayout) {
+			return time.ParseInLocation(podioDateLayout, (*s)[:len(podioDateLayout)], loc)
+		}
+	}
+	if local != nil && !local.IsZero() {
+		year, month, day := local.Date()
+		return time.Date(year, month, day, 0, 0, 0, 0, loc), nil
+	}
+	return time.Time{}, nil
+}
+
+// parseInstant reads a UTC time from the combined utc value or from its date and time parts
+func parseInstant(utc, dateUTC, timeUTC *string) (time.Time, error) {
+	switch {
+	case utc != nil && len(*utc) > len(podioDateLayout):
+		return time.ParseInLocation(podioLayout, *utc, time.UTC)
+	case dateUTC != nil && timeUTC != nil:
+		return time.ParseInLocation(podioDateLayout+" "+podioTimeLayout, *dateUTC+" "+*timeUTC, time.UTC)
+	case utc != nil:
+		return time.Time{}, fmt.Errorf("podio: %q has no time of day", *utc)
+	}
+	return time.Time{}, nil
+}
+
+func inLocation(t time.Time, loc *time.Location) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.In(loc)
+}
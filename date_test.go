@@ -0,0 +1,75 @@
+package podio
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateValueIn(t *testing.T) {
+	r := require.New(t)
+	cet := time.FixedZone("CET", 3600)
+
+	var values []DateValue
+	r.NoError(json.Unmarshal([]byte(`[
+		{"start": "2024-03-01 00:00:00", "end": "2024-03-03 00:00:00", "start_date": "2024-03-01", "end_date": "2024-03-03", "start_time": null, "end_time": null, "start_utc": "2024-03-01", "end_utc": "2024-03-03"},
+		{"start": "2024-03-01 11:00:00", "start_date": "2024-03-01", "start_time": "11:00:00", "start_utc": "2024-03-01 10:00:00"},
+		{"start_date_utc": "2024-03-01", "start_time_utc": "23:30:00", "end_date_utc": "2024-03-02", "end_time_utc": "01:00:00"},
+		{"start": "2024-03-01 10:00:00"}
+	]`), &values))
+
+	allDay, err := values[0].In(cet)
+	r.NoError(err)
+	r.True(allDay.AllDay)
+	r.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, cet), allDay.Start)
+	r.Equal(time.Date(2024, 3, 3, 0, 0, 0, 0, cet), allDay.End)
+
+	timed, err := values[1].In(cet)
+	r.NoError(err)
+	r.False(timed.AllDay)
+	r.False(timed.HasEnd())
+	r.Equal(time.Date(2024, 3, 1, 11, 0, 0, 0, cet), timed.Start)
+
+	parts, err := values[2].In(cet)
+	r.NoError(err)
+	r.Equal(time.Date(2024, 3, 2, 0, 30, 0, 0, cet), parts.Start)
+	r.Equal(time.Date(2024, 3, 2, 2, 0, 0, 0, cet), parts.End)
+
+	local, err := values[3].In(nil)
+	r.NoError(err)
+	r.Equal(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), local.Start)
+
+	user := &User{Timezone: "Europe/Copenhagen"}
+	loc, err := user.Location()
+	r.NoError(err)
+	inUserZone, err := values[1].In(loc)
+	r.NoError(err)
+	r.Equal("2024-03-01 11:00", inUserZone.Start.Format("2006-01-02 15:04"))
+}
+
+func TestUnmarshalCalculationReturnTypes(t *testing.T) {
+	r := require.New(t)
+
+	item := &Item{}
+	r.NoError(json.Unmarshal([]byte(`{"fields": [
+		{"field_id": 1, "external_id": "cost", "type": "calculation", "config": {"settings": {"return_type": "money"}}, "values": [{"value": "50.0000", "currency": "EUR"}]},
+		{"field_id": 2, "external_id": "span", "type": "calculation", "config": {"settings": {"return_type": "date"}}, "values": [{"start_utc": "2024-03-01 10:00:00", "end_utc": "2024-03-01 12:00:00"}]},
+		{"field_id": 3, "external_id": "spent", "type": "calculation", "config": {"settings": {"return_type": "duration"}}, "values": [{"value": 3600}]},
+		{"field_id": 4, "external_id": "other", "type": "calculation", "config": {"settings": {"return_type": "html"}}, "values": [{"value": "<b>x</b>"}]}
+	]}`), item))
+
+	cost, err := item.Field("cost").Money()
+	r.NoError(err)
+	r.Equal(MoneyValue{Value: 50, Currency: "EUR"}, *cost)
+
+	span, err := item.Field("span").Date()
+	r.NoError(err)
+	date, err := span.In(time.UTC)
+	r.NoError(err)
+	r.Equal(2*time.Hour, date.End.Sub(date.Start))
+
+	r.Equal([]DurationValue{{Value: 3600}}, item.Field("spent").Raw().Values)
+	r.Equal([]CalculationValue{{"value": "<b>x</b>"}}, item.Field("other").Raw().Values)
+}
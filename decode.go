@@ -0,0 +1,192 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// DecodeMode sets how a client handles field values it cannot decode, see WithDecodeMode
+type DecodeMode int
+
+const (
+	// DecodeDefault logs fields of unknown types and malformed values, the problems are left
+	// in the Warnings of the items
+	DecodeDefault DecodeMode = iota
+	// DecodeStrict fails on fields of unknown types and on malformed values
+	DecodeStrict
+	// DecodeLenient neither fails nor logs, the problems are only left in the Warnings of the items
+	DecodeLenient
+)
+
+// ErrUnknownFieldType is wrapped by the FieldDecodeError of a field of a type this package doesn't know
+var ErrUnknownFieldType = errors.New("unknown field type")
+
+// FieldDecodeError describes a field whose values could not be decoded
+type FieldDecodeError struct {
+	FieldId    FieldID
+	ExternalId string
+	Type       string
+	Err        error
+}
+
+func (e *FieldDecodeError) Error() string {
+	return fmt.Sprintf("podio: cannot decode field %d (%s) of type %s: %v", e.FieldId, e.ExternalId, e.Type, e.Err)
+}
+
+func (e *FieldDecodeError) Unwrap() error {
+	return e.Err
+}
+
+func (f *Field) decodeError(err error) *FieldDecodeError {
+	return &FieldDecodeError{FieldId: f.Id, ExternalId: f.ExternalId, Type: f.Type, Err: err}
+}
+
+// WithDecodeMode sets how the client handles fields it cannot decode. Items are always decoded
+// completely, fields that failed are kept with the values that could be read and listed in the
+// Warnings of the item. The mode decides whether the request returns them as an error:
+//
+//	client := podio.NewClient(token, podio.WithDecodeMode(podio.DecodeStrict))
+//	item, err := client.GetItemCtx(ctx, itemId)
+//	var decodeErr *podio.FieldDecodeError
+//	if errors.As(err, &decodeErr) {
+//		// decodeErr.FieldId, decodeErr.ExternalId and decodeErr.Type name the field
+//	}
+func WithDecodeMode(mode DecodeMode) ClientOption {
+	return func(client *Client) {
+		client.decodeMode = mode
+	}
+}
+
+// decodeFields decodes the fields of an item one by one, so a field that fails doesn't stop the others
+func decodeFields(raw []json.RawMessage) (fields []*Field, warnings []*FieldDecodeError) {
+	if raw == nil {
+		return nil, nil
+	}
+	fields = make([]*Field, 0, len(raw))
+	for _, data := range raw {
+		if string(data) == "null" {
+			fields = append(fields, nil)
+			continue
+		}
+		field := &Field{}
+		if err := json.Unmarshal(data, field); err != nil {
+			var decodeErr *FieldDecodeError
+			if !errors.As(err, &decodeErr) {
+				decodeErr = field.decodeError(err)
+			}
+			warnings = append(warnings, decodeErr)
+		} else if field.warning != nil {
+			warnings = append(warnings, field.warning)
+		}
+		fields = append(fields, field)
+	}
+	return
+}
+
+// checkDecoded applies the decode mode of the client to the fields decoded into out
+func (client *Client) checkDecoded(ctx context.Context, out interface{}) error {
+	if client.decodeMode == DecodeLenient {
+		return nil
+	}
+	var errs []error
+	visitDecodeWarnings(reflect.ValueOf(out), func(warning *FieldDecodeError) {
+		if client.decodeMode == DecodeStrict {
+			errs = append(errs, warning)
+			return
+		}
+		msg := "undecodable_app_field"
+		if errors.Is(warning, ErrUnknownFieldType) {
+			msg = "unknown_app_field"
+		}
+		client.log().WarnContext(ctx, msg, "context", "podio_item", "type", warning.Type, "field_id", warning.FieldId, "external_id", warning.ExternalId, "error", warning.Err)
+	})
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
+var (
+	itemType       = reflect.TypeOf(Item{})
+	itemSimpleType = reflect.TypeOf(ItemSimple{})
+	fieldType      = reflect.TypeOf(Field{})
+
+	// fieldHolders caches holdsFields by type
+	fieldHolders sync.Map
+)
+
+// visitDecodeWarnings calls visit for the warnings of the items and fields in v
+func visitDecodeWarnings(v reflect.Value, visit func(*FieldDecodeError)) {
+	if !v.IsValid() || !holdsFields(v.Type()) {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			visitDecodeWarnings(v.Elem(), visit)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			visitDecodeWarnings(v.Index(i), visit)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			visitDecodeWarnings(iter.Value(), visit)
+		}
+	case reflect.Struct:
+		switch v.Type() {
+		case itemType, itemSimpleType:
+			for _, warning := range v.FieldByName("Warnings").Interface().([]*FieldDecodeError) {
+				visit(warning)
+			}
+		case fieldType:
+			if v.CanAddr() {
+				if warning := v.Addr().Interface().(*Field).warning; warning != nil {
+					visit(warning)
+				}
+			}
+		default:
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).IsExported() {
+					visitDecodeWarnings(v.Field(i), visit)
+				}
+			}
+		}
+	}
+}
+
+// holdsFields reports whether values of type t can contain decoded fields
+func holdsFields(t reflect.Type) bool {
+	if holds, ok := fieldHolders.Load(t); ok {
+		return holds.(bool)
+	}
+	holds := typeHoldsFields(t, map[reflect.Type]bool{})
+	fieldHolders.Store(t, holds)
+	return holds
+}
+
+func typeHoldsFields(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return typeHoldsFields(t.Elem(), seen)
+	case reflect.Struct:
+		if t == itemType || t == itemSimpleType || t == fieldType {
+			return true
+		}
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).IsExported() && typeHoldsFields(t.Field(i).Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
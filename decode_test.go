@@ -0,0 +1,63 @@
+package podio
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeModes(t *testing.T) {
+	r := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"item_id": 1, "fields": [
+			{"field_id": 10, "external_id": "title", "type": "text", "values": [{"value": "Launch"}]},
+			{"field_id": 11, "external_id": "hours", "type": "number", "values": [{"value": "many"}]},
+			{"field_id": 12, "external_id": "future", "type": "hologram", "values": [{"value": 1}]}
+		]}`))
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	var logged bytes.Buffer
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL), WithLogger(slog.New(slog.NewTextHandler(&logged, nil))))
+	item, err := client.GetItemCtx(ctx, 1)
+	r.NoError(err)
+	r.Equal("Launch", item.Fields[0].Values.([]TextValue)[0].Value)
+	r.Len(item.Warnings, 2)
+	r.Contains(logged.String(), "undecodable_app_field")
+	r.Contains(logged.String(), "field_id=11")
+	r.Contains(logged.String(), "unknown_app_field")
+
+	client = NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL), WithDecodeMode(DecodeStrict))
+	_, err = client.GetItemCtx(ctx, 1)
+	var decodeErr *FieldDecodeError
+	r.ErrorAs(err, &decodeErr)
+	r.Equal(FieldID(11), decodeErr.FieldId)
+	r.Equal("hours", decodeErr.ExternalId)
+	r.Equal("number", decodeErr.Type)
+	r.ErrorIs(err, ErrUnknownFieldType)
+	r.ErrorContains(err, "podio: cannot decode field 12 (future) of type hologram: unknown field type")
+
+	client = NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL), WithDecodeMode(DecodeLenient))
+	item, err = client.GetItemCtx(ctx, 1)
+	r.NoError(err)
+	r.Len(item.Warnings, 2)
+	r.Equal(FieldID(11), item.Warnings[0].FieldId)
+	r.Equal(FieldID(12), item.Warnings[1].FieldId)
+	r.True(item.Field("future").Exists())
+
+	// the warnings of items nested in other responses are found too
+	var found []*FieldDecodeError
+	list := &ItemListSimple{Items: []*ItemSimple{{Warnings: item.Warnings}}}
+	visitDecodeWarnings(reflect.ValueOf(list), func(warning *FieldDecodeError) {
+		found = append(found, warning)
+	})
+	r.Len(found, 2)
+}
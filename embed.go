@@ -1,5 +1,7 @@
 package podio
 
+import "context"
+
 // Embed describes a Podio embed object
 type Embed struct {
 	Id          int    `json:"embed_id"`
@@ -19,11 +21,16 @@ type EmbedSimple struct {
 	Id              int    `json:"podio_id"`
 	Title           string `json:"title"`
 	URL             string `json:"url"`
-	ThumbnailFileId int    `json:"thumbnail_file_id"`
+	ThumbnailFileId FileID `json:"thumbnail_file_id"`
 }
 
 // https://developers.podio.com/doc/embeds/add-an-embed-726483
 func (client *Client) CreateEmbed(params map[string]interface{}) (embed *Embed, err error) {
-	err = client.RequestWithParams("POST", "/embed/", nil, params, &embed)
+	return client.CreateEmbedCtx(context.Background(), params)
+}
+
+// CreateEmbedCtx is the context-aware version of CreateEmbed.
+func (client *Client) CreateEmbedCtx(ctx context.Context, params map[string]interface{}) (embed *Embed, err error) {
+	err = client.RequestWithParamsCtx(ctx, "POST", "/embed/", nil, params, &embed)
 	return
 }
@@ -0,0 +1,84 @@
+package podio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Sentinel errors to check an *Error against with errors.Is, e.g.
+//
+//	if errors.Is(err, podio.ErrGone) {
+//		// the item was deleted
+//	}
+var (
+	ErrUnauthorized = errors.New("podio: unauthorized")
+	ErrForbidden    = errors.New("podio: forbidden")
+	ErrNotFound     = errors.New("podio: not found")
+	ErrConflict     = errors.New("podio: conflict")
+	ErrGone         = errors.New("podio: gone")
+	ErrRateLimited  = errors.New("podio: rate limited")
+)
+
+// Error is returned for every non 2xx response. The json fields are filled from
+// Podio's error body, the other fields describe the request that failed.
+type Error struct {
+	Parameters interface{} `json:"error_parameters"`
+	Detail     interface{} `json:"error_detail"`
+	Propagate  bool        `json:"error_propagate"`
+	Request    struct {
+		URL   string `json:"url"`
+		Query string `json:"query_string"`
+	} `json:"request"`
+	Description string `json:"error_description"`
+	Type        string `json:"error"`
+
+	StatusCode int           `json:"-"`
+	Method     string        `json:"-"`
+	Path       string        `json:"-"`
+	RetryAfter time.Duration `json:"-"` // 0 when the response had no Retry-After header
+}
+
+func (p *Error) Error() string {
+	if p.Type == "" {
+		// the body was not a Podio error (e.g. an html page of a proxy)
+		return fmt.Sprintf("Podio status code: %d. %s", p.StatusCode, p.Description)
+	}
+	return fmt.Sprintf("%s: %s", p.Type, p.Description)
+}
+
+// Is makes errors.Is(err, ErrNotFound) etc. work based on the status code
+func (p *Error) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return p.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return p.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return p.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return p.StatusCode == http.StatusConflict
+	case ErrGone:
+		return p.StatusCode == http.StatusGone
+	case ErrRateLimited:
+		return isRateLimited(p.StatusCode)
+	}
+	return false
+}
+
+func newError(resp *http.Response, respBody []byte) *Error {
+	podioErr := &Error{}
+	if err := json.Unmarshal(respBody, podioErr); err != nil {
+		podioErr = &Error{Description: distillErrFromBody(string(respBody))}
+	}
+
+	podioErr.StatusCode = resp.StatusCode
+	podioErr.RetryAfter = parseRetryAfter(resp.Header)
+	if resp.Request != nil {
+		podioErr.Method = resp.Request.Method
+		podioErr.Path = resp.Request.URL.Path
+	}
+	return podioErr
+}
@@ -0,0 +1,43 @@
+package podio
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorCarriesStatusAndMatchesSentinels(t *testing.T) {
+	r := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/item/1":
+			w.WriteHeader(http.StatusGone)
+			w.Write([]byte(`{"error": "gone", "error_description": "The item has been deleted"}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`<html><h1>Forbidden</h1></html>`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+	_, err := client.GetItemSimple(1)
+	r.True(errors.Is(err, ErrGone))
+	r.False(errors.Is(err, ErrNotFound))
+
+	var podioErr *Error
+	r.True(errors.As(err, &podioErr))
+	r.Equal(http.StatusGone, podioErr.StatusCode)
+	r.Equal("GET", podioErr.Method)
+	r.Equal("/item/1", podioErr.Path)
+	r.Equal("gone: The item has been deleted", err.Error())
+
+	_, err = client.GetSpace(2)
+	r.True(errors.Is(err, ErrForbidden))
+	r.Equal("Podio status code: 403. Forbidden", err.Error())
+}
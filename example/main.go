@@ -33,7 +33,7 @@ func main() {
 		for _, space := range spaces {
 			fmt.Println("Space: ", space.Name)
 
-			apps, err := client.GetApps(space.Id)
+			apps, err := client.GetApps(int64(space.Id), nil)
 			if err != nil {
 				fmt.Println("Failed to get apps: ", err)
 				continue
@@ -42,7 +42,7 @@ func main() {
 			for _, app := range apps {
 				fmt.Println("App: ", app.Name)
 
-				items, err := client.GetItems(app.Id)
+				items, err := client.GetItems(int64(app.Id))
 				if err != nil {
 					fmt.Println("Failed to get items: ", err)
 					continue
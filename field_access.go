@@ -0,0 +1,231 @@
+package podio
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FieldTypeError is returned by the FieldValues accessors when the field has another type
+type FieldTypeError struct {
+	FieldId    FieldID
+	ExternalId string
+	Type       string
+	Want       string
+}
+
+func (e *FieldTypeError) Error() string {
+	return fmt.Sprintf("podio: field %d (%s) is of type %s, not %s", e.FieldId, e.ExternalId, e.Type, e.Want)
+}
+
+// FieldValues reads the values of one field of an item, get it with Item.Field or ItemSimple.Field.
+//
+// Podio leaves fields without values out of items, so the accessors of a missing field
+// return zero values without an error. Exists tells the two apart.
+type FieldValues struct {
+	field *Field
+}
+
+// fieldIndex maps external ids and field ids (see FieldKey) to the positions of the fields of an item
+type fieldIndex map[string]int
+
+func newFieldIndex(fields []*Field) fieldIndex {
+	index := make(fieldIndex, 2*len(fields))
+	for i, field := range fields {
+		if field == nil {
+			continue
+		}
+		index[FieldKey(field.Id)] = i
+		if field.ExternalId != "" {
+			index[field.ExternalId] = i
+		}
+	}
+	return index
+}
+
+// lookupField uses the index built when the item was decoded. Fields may have been changed,
+// added or removed since, so a hit is checked against fields and a miss searches them.
+func lookupField(index fieldIndex, fields []*Field, key string) *Field {
+	if i, ok := index[key]; ok && i < len(fields) && fieldHasKey(fields[i], key) {
+		return fields[i]
+	}
+	for _, field := range fields {
+		if fieldHasKey(field, key) {
+			return field
+		}
+	}
+	return nil
+}
+
+func fieldHasKey(field *Field, key string) bool {
+	return field != nil && (field.ExternalId == key || FieldKey(field.Id) == key)
+}
+
+func (item *Item) UnmarshalJSON(data []byte) error {
+	type plainItem Item
+	decoded := struct {
+		*plainItem
+		Fields []json.RawMessage `json:"fields"`
+	}{plainItem: (*plainItem)(item)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	item.Fields, item.Warnings = decodeFields(decoded.Fields)
+	item.fieldIndex = newFieldIndex(item.Fields)
+	return nil
+}
+
+func (item *ItemSimple) UnmarshalJSON(data []byte) error {
+	type plainItem ItemSimple
+	decoded := struct {
+		*plainItem
+		Fields []json.RawMessage `json:"fields"`
+	}{plainItem: (*plainItem)(item)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	item.Fields, item.Warnings = decodeFields(decoded.Fields)
+	item.fieldIndex = newFieldIndex(item.Fields)
+	return nil
+}
+
+// Field returns the values of the field with the external id or field id (see FieldKey) key
+//
+//	status, err := item.Field("status").Category()
+func (item *Item) Field(key string) FieldValues {
+	return FieldValues{field: lookupField(item.fieldIndex, item.Fields, key)}
+}
+
+// Field returns the values of the field with the external id or field id (see FieldKey) key
+func (item *ItemSimple) Field(key string) FieldValues {
+	return FieldValues{field: lookupField(item.fieldIndex, item.Fields, key)}
+}
+
+// Exists reports whether the item has the field
+func (v FieldValues) Exists() bool {
+	return v.field != nil
+}
+
+// Raw returns the field, nil if the item doesn't have it
+func (v FieldValues) Raw() *Field {
+	return v.field
+}
+
+// check returns an error unless the field has the type want, calculations match their return type
+func (v FieldValues) check(want string) error {
+	fieldType := v.field.Type
+	if fieldType == "calculation" {
+		fieldType = v.field.Config.Settings.ReturnType
+	}
+	if fieldType == want {
+		return nil
+	}
+	return &FieldTypeError{FieldId: v.field.Id, ExternalId: v.field.ExternalId, Type: v.field.Type, Want: want}
+}
+
+// Text returns the value of a text field or a calculation returning text
+func (v FieldValues) Text() (string, error) {
+	if v.field == nil {
+		return "", nil
+	}
+	if err := v.check("text"); err != nil {
+		return "", err
+	}
+	values, _ := v.field.Values.([]TextValue)
+	if len(values) == 0 {
+		return "", nil
+	}
+	return values[0].Value, nil
+}
+
+// Number returns the value of a number field or a calculation returning a number
+func (v FieldValues) Number() (float64, error) {
+	if v.field == nil {
+		return 0, nil
+	}
+	if err := v.check("number"); err != nil {
+		return 0, err
+	}
+	values, _ := v.field.Values.([]NumberValue)
+	if len(values) == 0 {
+		return 0, nil
+	}
+	return values[0].Value, nil
+}
+
+// Date returns the value of a date field or a calculation returning a date, nil when empty
+func (v FieldValues) Date() (*DateValue, error) {
+	if v.field == nil {
+		return nil, nil
+	}
+	if err := v.check("date"); err != nil {
+		return nil, err
+	}
+	values, _ := v.field.Values.([]DateValue)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return &values[0], nil
+}
+
+// Money returns the value of a money field, nil when empty
+func (v FieldValues) Money() (*MoneyValue, error) {
+	if v.field == nil {
+		return nil, nil
+	}
+	if err := v.check("money"); err != nil {
+		return nil, err
+	}
+	values, _ := v.field.Values.([]MoneyValue)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return &values[0], nil
+}
+
+// Category returns the selected options of a category field
+func (v FieldValues) Category() ([]CategoryOption, error) {
+	if v.field == nil {
+		return nil, nil
+	}
+	if err := v.check("category"); err != nil {
+		return nil, err
+	}
+	values, _ := v.field.Values.([]CategoryValue)
+	options := make([]CategoryOption, len(values))
+	for i, value := range values {
+		options[i] = value.Value
+	}
+	return options, nil
+}
+
+// AppRefs returns the items referenced by an app reference field
+func (v FieldValues) AppRefs() ([]Item, error) {
+	if v.field == nil {
+		return nil, nil
+	}
+	if err := v.check("app"); err != nil {
+		return nil, err
+	}
+	values, _ := v.field.Values.([]AppValue)
+	items := make([]Item, len(values))
+	for i, value := range values {
+		items[i] = value.Value
+	}
+	return items, nil
+}
+
+// Contacts returns the contacts of a contact field
+func (v FieldValues) Contacts() ([]Contact, error) {
+	if v.field == nil {
+		return nil, nil
+	}
+	if err := v.check("contact"); err != nil {
+		return nil, err
+	}
+	values, _ := v.field.Values.([]ContactValue)
+	contacts := make([]Contact, len(values))
+	for i, value := range values {
+		contacts[i] = value.Value
+	}
+	return contacts, nil
+}
@@ -0,0 +1,105 @@
+package podio
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemFieldAccessors(t *testing.T) {
+	r := require.New(t)
+
+	item := &Item{}
+	err := json.Unmarshal([]byte(`{
+		"item_id": 1,
+		"fields": [
+			{"field_id": 10, "external_id": "title", "type": "text", "values": [{"value": "Launch"}]},
+			{"field_id": 11, "external_id": "status", "type": "category", "values": [{"value": {"id": 2, "text": "Done", "status": "active"}}]},
+			{"field_id": 12, "external_id": "total", "type": "calculation", "config": {"settings": {"return_type": "number"}}, "values": [{"value": "12.5000"}]},
+			{"field_id": 13, "external_id": "project", "type": "app", "values": [{"value": {"item_id": 7, "title": "Apollo"}}]},
+			{"field_id": 14, "external_id": "owner", "type": "contact", "values": [{"value": {"profile_id": 3, "name": "Ann"}}]},
+			{"field_id": 15, "external_id": "budget", "type": "money", "values": [{"value": "100.5", "currency": "EUR"}]},
+			{"field_id": 16, "external_id": "deadline", "type": "date", "values": [{"start": "2024-03-01 00:00:00"}]}
+		]
+	}`), item)
+	r.NoError(err)
+
+	title, err := item.Field("title").Text()
+	r.NoError(err)
+	r.Equal("Launch", title)
+
+	status, err := item.Field(FieldKey(11)).Category()
+	r.NoError(err)
+	r.Equal([]CategoryOption{{Id: 2, Text: "Done", Status: "active"}}, status)
+
+	total, err := item.Field("total").Number()
+	r.NoError(err)
+	r.Equal(12.5, total)
+
+	projects, err := item.Field("project").AppRefs()
+	r.NoError(err)
+	r.Equal(ItemID(7), projects[0].Id)
+
+	owners, err := item.Field("owner").Contacts()
+	r.NoError(err)
+	r.Equal("Ann", owners[0].Name)
+
+	budget, err := item.Field("budget").Money()
+	r.NoError(err)
+	r.Equal(MoneyValue{Value: 100.5, Currency: "EUR"}, *budget)
+
+	deadline, err := item.Field("deadline").Date()
+	r.NoError(err)
+	r.NotNil(deadline.Start)
+
+	_, err = item.Field("title").Number()
+	var typeErr *FieldTypeError
+	r.ErrorAs(err, &typeErr)
+	r.Equal(FieldID(10), typeErr.FieldId)
+	r.Equal("text", typeErr.Type)
+
+	missing := item.Field("missing")
+	r.False(missing.Exists())
+	text, err := missing.Text()
+	r.NoError(err)
+	r.Equal("", text)
+}
+
+func TestItemSimpleFieldWithoutIndex(t *testing.T) {
+	r := require.New(t)
+
+	field := &Field{PartialField: PartialField{Id: 10, ExternalId: "title", Type: "text"}, Values: []TextValue{{Value: "Launch"}}}
+	item := &ItemSimple{Fields: []*Field{field}}
+
+	title, err := item.Field("title").Text()
+	r.NoError(err)
+	r.Equal("Launch", title)
+	r.True(item.Field("10").Exists())
+}
+
+func TestFieldIndexAfterFieldsChange(t *testing.T) {
+	r := require.New(t)
+
+	item := &Item{}
+	r.NoError(json.Unmarshal([]byte(`{"item_id": 1, "fields": [
+		{"field_id": 10, "external_id": "title", "type": "text", "values": [{"value": "Launch"}]},
+		{"field_id": 11, "external_id": "notes", "type": "text", "values": [{"value": "Later"}]}
+	]}`), item))
+
+	item.Fields = item.Fields[1:]
+	r.False(item.Field("title").Exists())
+	notes, err := item.Field("notes").Text()
+	r.NoError(err)
+	r.Equal("Later", notes)
+
+	item.Fields = append(item.Fields, &Field{PartialField: PartialField{Id: 12, ExternalId: "summary", Type: "text"}, Values: []TextValue{{Value: "New"}}})
+	summary, err := item.Field("summary").Text()
+	r.NoError(err)
+	r.Equal("New", summary)
+
+	item.Fields[0] = &Field{PartialField: PartialField{Id: 13, ExternalId: "notes", Type: "text"}, Values: []TextValue{{Value: "Replaced"}}}
+	notes, err = item.Field("notes").Text()
+	r.NoError(err)
+	r.Equal("Replaced", notes)
+}
@@ -0,0 +1,196 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Views of objects in partial responses
+const (
+	ViewMicro = "micro"
+	ViewMini  = "mini"
+	ViewShort = "short"
+	ViewFull  = "full"
+)
+
+// FieldSelector is a part of the "fields" query parameter that trims or extends responses,
+// see https://developers.podio.com/index/api (Partial responses):
+//
+//	podio.Select("items").View(podio.ViewMicro).Fields(podio.Select("external_id"))
+//	// items.view(micro).fields(external_id)
+//
+// Selectors are values, View and Fields return changed copies.
+type FieldSelector struct {
+	name    string
+	view    string
+	exclude bool
+	fields  []FieldSelector
+}
+
+// Select selects the field name of a response
+func Select(name string) FieldSelector {
+	return FieldSelector{name: name}
+}
+
+// Exclude leaves the field name out of a response
+func Exclude(name string) FieldSelector {
+	return FieldSelector{name: name, exclude: true}
+}
+
+// View returns the field in the given view, e.g. ViewMicro
+func (s FieldSelector) View(view string) FieldSelector {
+	s.view = view
+	return s
+}
+
+// Fields selects fields of the field, e.g. the fields of the items in a filter response
+func (s FieldSelector) Fields(fields ...FieldSelector) FieldSelector {
+	s.fields = append(append([]FieldSelector(nil), s.fields...), fields...)
+	return s
+}
+
+func (s FieldSelector) String() string {
+	if s.exclude {
+		return "-" + s.name
+	}
+	selector := s.name
+	if s.view != "" {
+		selector += ".view(" + s.view + ")"
+	}
+	if len(s.fields) > 0 {
+		selector += ".fields(" + joinSelectors(s.fields) + ")"
+	}
+	return selector
+}
+
+var selectorName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func (s FieldSelector) validate() error {
+	if !selectorName.MatchString(s.name) {
+		return fmt.Errorf("podio: invalid field name %q in fields selector", s.name)
+	}
+	if s.view != "" && !selectorName.MatchString(s.view) {
+		return fmt.Errorf("podio: invalid view %q in fields selector", s.view)
+	}
+	if s.exclude && (s.view != "" || len(s.fields) > 0) {
+		return fmt.Errorf("podio: excluded field %q cannot have a view or fields", s.name)
+	}
+	for _, field := range s.fields {
+		if err := field.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func joinSelectors(selectors []FieldSelector) string {
+	parts := make([]string, len(selectors))
+	for i, selector := range selectors {
+		parts[i] = selector.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+type fieldsKey struct{}
+
+type fieldsSelection struct {
+	value string
+	err   error
+}
+
+// WithFields adds to the "fields" query parameter of the GET and filter requests made with
+// the returned context, other requests like creates and updates are sent unchanged. The
+// selectors are merged with the selection the method uses itself, a selector for the same
+// field replaces the method's one:
+//
+//	ctx = podio.WithFields(ctx, podio.Select("items").View(podio.ViewMini), podio.Exclude("filters"))
+//	items, err := client.FilterItemsCtx(ctx, appId, params)
+//
+// Every page of an iterator is requested with the selectors, so they must keep the fields
+// the iterator pages by, e.g. "total" or "filtered" for item filters.
+func WithFields(ctx context.Context, selectors ...FieldSelector) context.Context {
+	selection := fieldsSelection{value: joinSelectors(selectors)}
+	for _, selector := range selectors {
+		if err := selector.validate(); err != nil {
+			selection.err = err
+			break
+		}
+	}
+	return context.WithValue(ctx, fieldsKey{}, selection)
+}
+
+// applyFields merges the fields selected with WithFields into the query of path,
+// if the request reads objects
+func applyFields(ctx context.Context, method string, path string) (string, error) {
+	selection, ok := ctx.Value(fieldsKey{}).(fieldsSelection)
+	if !ok || selection.value == "" {
+		return path, nil
+	}
+	if selection.err != nil {
+		return path, selection.err
+	}
+
+	pathURL, err := url.Parse(path)
+	if err != nil {
+		return path, err
+	}
+	if method != http.MethodGet && !isFilterRequest(method, pathURL.Path) {
+		return path, nil
+	}
+	query := pathURL.Query()
+	query.Set("fields", mergeSelectors(query.Get("fields"), selection.value))
+	pathURL.RawQuery = query.Encode()
+	return pathURL.String(), nil
+}
+
+// mergeSelectors adds the selectors of extra to fields, dropping the selectors of fields
+// for the same field names
+func mergeSelectors(fields, extra string) string {
+	if fields == "" {
+		return extra
+	}
+	replaced := map[string]bool{}
+	for _, selector := range splitSelectors(extra) {
+		replaced[selectorFieldName(selector)] = true
+	}
+	var merged []string
+	for _, selector := range splitSelectors(fields) {
+		if !replaced[selectorFieldName(selector)] {
+			merged = append(merged, selector)
+		}
+	}
+	return strings.Join(append(merged, extra), ",")
+}
+
+// splitSelectors splits a fields parameter at the commas outside of parentheses
+func splitSelectors(fields string) []string {
+	var selectors []string
+	depth, start := 0, 0
+	for i, r := range fields {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				selectors = append(selectors, fields[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(selectors, fields[start:])
+}
+
+// selectorFieldName is the name of the field a selector is about, e.g. items for items.view(micro)
+func selectorFieldName(selector string) string {
+	name := strings.TrimPrefix(strings.TrimSpace(selector), "-")
+	if i := strings.IndexAny(name, ".("); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
@@ -0,0 +1,62 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldSelectorString(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("items.view(micro).fields(external_id)", microItemsWithExternalId.String())
+	r.Equal("items.fields(files,tags)", itemsWithFilesAndTags.String())
+
+	items := Select("items").View(ViewMini)
+	r.Equal("items.view(mini).fields(app,-tags)", items.Fields(Select("app"), Exclude("tags")).String())
+	r.Equal("items.view(mini)", items.String(), "Fields must not change the selector it is called on")
+}
+
+func TestWithFields(t *testing.T) {
+	r := require.New(t)
+
+	var fields []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fields = append(fields, req.URL.Query().Get("fields"))
+		w.Write([]byte(`{"items": []}`))
+	}))
+	defer server.Close()
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+	_, err := client.FilterItemsCtx(context.Background(), 1, nil)
+	r.NoError(err)
+	ctx := WithFields(context.Background(), Select("items").View(ViewMicro), Exclude("filters"))
+	_, err = client.FilterItemsCtx(ctx, 1, nil)
+	r.NoError(err)
+	r.Equal([]string{"items.fields(files,tags)", "items.view(micro),-filters"}, fields)
+
+	// merged with the selection of the method, writes are left alone
+	fields = nil
+	ctx = WithFields(context.Background(), Exclude("filters"))
+	_, err = client.FilterItemsCtx(ctx, 1, nil)
+	r.NoError(err)
+	_, err = client.GetAppCtx(ctx, 1)
+	r.NoError(err)
+	r.NoError(client.UpdateItemCtx(ctx, 1, map[string]interface{}{"title": "x"}))
+	r.Equal([]string{"items.fields(files,tags),-filters", "-filters", ""}, fields)
+
+	_, err = client.FilterItemsCtx(WithFields(context.Background(), Select("items.fields(x)")), 1, nil)
+	r.ErrorContains(err, "invalid field name")
+	r.Len(fields, 3)
+}
+
+func TestMergeSelectors(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("items.view(mini)", mergeSelectors("", "items.view(mini)"))
+	r.Equal("items.fields(files,tags),-filters", mergeSelectors("items.fields(files,tags)", "-filters"))
+	r.Equal("total,items.view(micro)", mergeSelectors("items.fields(files,tags),total", "items.view(micro)"))
+}
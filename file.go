@@ -2,6 +2,7 @@ package podio
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,21 +15,26 @@ import (
 )
 
 type File struct {
-	Id   int    `json:"file_id"`
+	Id   FileID `json:"file_id"`
 	Name string `json:"name"`
 	Link string `json:"link"`
 	Size int    `json:"size"`
-	Push Push   `json:"push"`
+	Push Push   `json:"push,omitzero"`
 
 	Mimetype    string     `json:"mimetype"`
 	Description string     `json:"description"`
-	Context     FileRef    `json:"context"`
-	CreatedBy   FileRef    `json:"created_by"`
-	CreatedVia  CreatedVia `json:"created_via"`
+	Context     FileRef    `json:"context,omitzero"`
+	CreatedBy   FileRef    `json:"created_by,omitzero"`
+	CreatedVia  CreatedVia `json:"created_via,omitzero"`
 	// AppFieldId 	int 		`json:"app_field_id"`
-	CreatedOn string `json:"created_on"` // we keep this simple to save on processing power
+	CreatedOn string `json:"created_on,omitempty"` // we keep this simple to save on processing power
 
-	Replaces []*File `json:"replaces"`
+	Replaces []*File `json:"replaces,omitempty"`
+}
+
+func (file File) MarshalJSON() ([]byte, error) {
+	type plain File
+	return marshalOmitZero(plain(file))
 }
 
 type CreatedVia struct {
@@ -49,24 +55,50 @@ type FileData struct {
 // we do not take the standard App struct
 // to save a bit on parsing + memory
 type FileApp struct {
-	Id int64 `json:"app_id"`
+	Id AppID `json:"app_id"`
 }
 
 // https://developers.podio.com/doc/files/get-files-4497983
 func (client *Client) GetFiles() (files []File, err error) {
-	err = client.Request("GET", "/file", nil, nil, &files)
+	return client.GetFilesCtx(context.Background())
+}
+
+// GetFilesCtx is the context-aware version of GetFiles.
+func (client *Client) GetFilesCtx(ctx context.Context) (files []File, err error) {
+	err = client.RequestCtx(ctx, "GET", "/file", nil, nil, &files)
 	return
 }
 
 // https://developers.podio.com/doc/files/get-file-22451
 func (client *Client) GetFile(fileId int) (file *File, err error) {
-	err = client.Request("GET", fmt.Sprintf("/file/%d", fileId), nil, nil, &file)
+	return client.GetFileCtx(context.Background(), FileID(fileId))
+}
+
+// GetFileCtx is the context-aware version of GetFile.
+func (client *Client) GetFileCtx(ctx context.Context, fileId FileID) (file *File, err error) {
+	err = client.RequestCtx(ctx, "GET", fmt.Sprintf("/file/%d", fileId), nil, nil, &file)
 	return
 }
 
 func (client *Client) GetFileContents(url string) ([]byte, error) {
-	link := fmt.Sprintf("%s?oauth_token=%s", url, client.authToken.AccessToken)
-	resp, err := http.Get(link)
+	return client.GetFileContentsCtx(context.Background(), url)
+}
+
+// GetFileContentsCtx is the context-aware version of GetFileContents.
+func (client *Client) GetFileContentsCtx(ctx context.Context, url string) ([]byte, error) {
+	token, err := client.currentToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	link := fmt.Sprintf("%s?oauth_token=%s", url, token.AccessToken)
+	ctx = withRequestInfo(ctx, &RequestInfo{Kind: RequestKindFile, Method: "GET", Path: url, Attempt: 1})
+	req, err := http.NewRequestWithContext(ctx, "GET", link, nil)
+	if err != nil {
+		return nil, err
+	}
+	client.setUserAgent(req)
+	resp, err := client.doer.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -79,12 +111,21 @@ func (client *Client) GetFileContents(url string) ([]byte, error) {
 		return nil, err
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, newError(resp, respBody)
+	}
+
 	return respBody, nil
 }
 
 func (client *Client) GetFileContentsToTempFile(url string) (tempFilePath, fileName, mimeType string, close func(), err error) {
+	return client.GetFileContentsToTempFileCtx(context.Background(), url)
+}
+
+// GetFileContentsToTempFileCtx is the context-aware version of GetFileContentsToTempFile.
+func (client *Client) GetFileContentsToTempFileCtx(ctx context.Context, url string) (tempFilePath, fileName, mimeType string, close func(), err error) {
 	var headers map[string]string
-	tempFilePath, headers, close, err = client.FileAndHeaders(url)
+	tempFilePath, headers, close, err = client.FileAndHeadersCtx(ctx, url)
 	if err != nil {
 		return
 	}
@@ -98,14 +139,14 @@ func (client *Client) GetFileContentsToTempFile(url string) (tempFilePath, fileN
 func FilenameFromHeaders(headers map[string]string) string {
 	contentDisposition, ok := headers["content-disposition"]
 	if !ok {
-		fmt.Println(`info="missing_file_header" missing_key='content-disposition'`)
+		defaultLogger().Info("missing_file_header", "missing_key", "content-disposition")
 		return ""
 	}
 
 	split := strings.Split(contentDisposition, "filename=\"")
 
 	if len(split) != 2 {
-		fmt.Printf(`info="unexpected_file_header" expected_value='inline; filename="..."' actual value='%s'\n`, contentDisposition)
+		defaultLogger().Info("unexpected_file_header", "expected_value", `inline; filename="..."`, "actual_value", contentDisposition)
 		return ""
 	}
 
@@ -114,9 +155,25 @@ func FilenameFromHeaders(headers map[string]string) string {
 }
 
 func (client *Client) FileAndHeaders(url string) (tempFilePath string, headers map[string]string, close func(), err error) {
+	return client.FileAndHeadersCtx(context.Background(), url)
+}
+
+// FileAndHeadersCtx is the context-aware version of FileAndHeaders.
+func (client *Client) FileAndHeadersCtx(ctx context.Context, url string) (tempFilePath string, headers map[string]string, close func(), err error) {
 	// step 1: download the contents
-	link := fmt.Sprintf("%s?oauth_token=%s", url, client.authToken.AccessToken)
-	resp, err := http.Get(link)
+	token, err := client.currentToken(ctx)
+	if err != nil {
+		return
+	}
+
+	link := fmt.Sprintf("%s?oauth_token=%s", url, token.AccessToken)
+	ctx = withRequestInfo(ctx, &RequestInfo{Kind: RequestKindFile, Method: "GET", Path: url, Attempt: 1})
+	req, err := http.NewRequestWithContext(ctx, "GET", link, nil)
+	if err != nil {
+		return
+	}
+	client.setUserAgent(req)
+	resp, err := client.doer.Do(req)
 
 	if err != nil {
 		return
@@ -130,8 +187,7 @@ func (client *Client) FileAndHeaders(url string) (tempFilePath string, headers m
 			err = fmt.Errorf("Could not read body: %v", err)
 			return
 		}
-		bodyContent := string(respBody)
-		err = fmt.Errorf("Podio status code: %d. %s", resp.StatusCode, distillErrFromBody(bodyContent))
+		err = newError(resp, respBody)
 		return
 	}
 
@@ -184,6 +240,11 @@ func distillErrFromBody(body string) string {
 
 // https://developers.podio.com/doc/files/upload-file-1004361
 func (client *Client) CreateFile(name string, contents []byte) (file *File, err error) {
+	return client.CreateFileCtx(context.Background(), name, contents)
+}
+
+// CreateFileCtx is the context-aware version of CreateFile.
+func (client *Client) CreateFileCtx(ctx context.Context, name string, contents []byte) (file *File, err error) {
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 
@@ -211,75 +272,128 @@ func (client *Client) CreateFile(name string, contents []byte) (file *File, err
 		"Content-Type": writer.FormDataContentType(),
 	}
 
-	err = client.Request("POST", "/file", headers, body, &file)
+	err = client.RequestCtx(ctx, "POST", "/file", headers, body, &file)
 	return
 }
 
 // https://developers.podio.com/doc/files/replace-file-22450
 func (client *Client) ReplaceFile(oldFileId, newFileId int) error {
+	return client.ReplaceFileCtx(context.Background(), FileID(oldFileId), FileID(newFileId))
+}
+
+// ReplaceFileCtx is the context-aware version of ReplaceFile.
+func (client *Client) ReplaceFileCtx(ctx context.Context, oldFileId, newFileId FileID) error {
 	path := fmt.Sprintf("/file/%d/replace", newFileId)
 	params := map[string]interface{}{
 		"old_file_id": oldFileId,
 	}
 
-	return client.RequestWithParams("POST", path, nil, params, nil)
+	return client.RequestWithParamsCtx(ctx, "POST", path, nil, params, nil)
 }
 
 // https://developers.podio.com/doc/files/attach-file-22518
 func (client *Client) AttachFile(fileId int, refType string, refId int64) error {
+	return client.AttachFileCtx(context.Background(), FileID(fileId), refType, refId)
+}
+
+// AttachFileCtx is the context-aware version of AttachFile.
+func (client *Client) AttachFileCtx(ctx context.Context, fileId FileID, refType string, refId int64) error {
 	path := fmt.Sprintf("/file/%d/attach", fileId)
 	params := map[string]interface{}{
 		"ref_type": refType,
 		"ref_id":   refId,
 	}
 
-	return client.RequestWithParams("POST", path, nil, params, nil)
+	return client.RequestWithParamsCtx(ctx, "POST", path, nil, params, nil)
 }
 
 // https://developers.podio.com/doc/files/delete-file-22453
 func (client *Client) DeleteFile(fileId int) error {
+	return client.DeleteFileCtx(context.Background(), FileID(fileId))
+}
+
+// DeleteFileCtx is the context-aware version of DeleteFile.
+func (client *Client) DeleteFileCtx(ctx context.Context, fileId FileID) error {
 	path := fmt.Sprintf("/file/%d", fileId)
-	return client.Request("DELETE", path, nil, nil, nil)
+	return client.RequestCtx(ctx, "DELETE", path, nil, nil, nil)
 }
 
 // https://developers.podio.com/doc/files/copy-file-89977
 func (client *Client) CopyFile(fileId int) (int, error) {
+	id, err := client.CopyFileCtx(context.Background(), FileID(fileId))
+	return int(id), err
+}
+
+// CopyFileCtx is the context-aware version of CopyFile.
+func (client *Client) CopyFileCtx(ctx context.Context, fileId FileID) (FileID, error) {
 	path := fmt.Sprintf("/file/%d/copy", fileId)
 	rsp := &struct {
-		FileId int `json:"file_id"`
+		FileId FileID `json:"file_id"`
 	}{}
-	err := client.Request("POST", path, nil, nil, rsp)
+	err := client.RequestCtx(ctx, "POST", path, nil, nil, rsp)
 	return rsp.FileId, err
 }
 
 // https://developers.podio.com/doc/files/get-files-on-space-22471
+//
+// Deprecated: use FindFilesForSpaceCtx with CaptureResponseWithBody to also get the raw JSON.
 func (client *Client) FindFilesForSpaceJson(spaceId int, params map[string]interface{}) (rawResponse *json.RawMessage, err error) {
+	return client.FindFilesForSpaceJsonCtx(context.Background(), SpaceID(spaceId), params)
+}
+
+// FindFilesForSpaceJsonCtx is the context-aware version of FindFilesForSpaceJson.
+//
+// Deprecated: use FindFilesForSpaceCtx with CaptureResponseWithBody to also get the raw JSON.
+func (client *Client) FindFilesForSpaceJsonCtx(ctx context.Context, spaceId SpaceID, params map[string]interface{}) (rawResponse *json.RawMessage, err error) {
 	path := fmt.Sprintf("/file/space/%d/", spaceId)
-	err = client.RequestWithParams("GET", path, nil, params, &rawResponse)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, params, &rawResponse)
 	return
 }
 
 // https://developers.podio.com/doc/files/get-files-on-space-22471
 func (client *Client) FindFilesForSpace(spaceId int64, params map[string]interface{}) (files []*File, err error) {
+	return client.FindFilesForSpaceCtx(context.Background(), SpaceID(spaceId), params)
+}
+
+// FindFilesForSpaceCtx is the context-aware version of FindFilesForSpace.
+func (client *Client) FindFilesForSpaceCtx(ctx context.Context, spaceId SpaceID, params map[string]interface{}) (files []*File, err error) {
 	path := fmt.Sprintf("/file/space/%d/", spaceId)
-	err = client.RequestWithParams("GET", path, nil, params, &files)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, params, &files)
 	return
 }
 
+// PaginateFilesForSpace pages through the files of FindFilesForSpace, limit and offset
+// in params are replaced by the page.
+func (client *Client) PaginateFilesForSpace(spaceId SpaceID, params map[string]interface{}, opts ...IterateOption) *Paginator[*File] {
+	return NewPaginator(func(ctx context.Context, limit, offset int) ([]*File, error) {
+		return client.FindFilesForSpaceCtx(ctx, spaceId, pageParams(params, limit, offset))
+	}, maxFileLimit, opts...)
+}
+
 // https://developers.podio.com/doc/files/get-files-on-app-22472
 func (client *Client) FindFilesForApp(appId int64, params map[string]interface{}) (files []*File, err error) {
+	return client.FindFilesForAppCtx(context.Background(), AppID(appId), params)
+}
+
+// FindFilesForAppCtx is the context-aware version of FindFilesForApp.
+func (client *Client) FindFilesForAppCtx(ctx context.Context, appId AppID, params map[string]interface{}) (files []*File, err error) {
 	path := fmt.Sprintf("/file/app/%d/", appId)
-	err = client.RequestWithParams("GET", path, nil, params, &files)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, params, &files)
 	return
 }
 
 // https://developers.podio.com/doc/files/update-file-22454
 func (client *Client) UpdateFile(fileId int, description string) (err error) {
+	return client.UpdateFileCtx(context.Background(), FileID(fileId), description)
+}
+
+// UpdateFileCtx is the context-aware version of UpdateFile.
+func (client *Client) UpdateFileCtx(ctx context.Context, fileId FileID, description string) (err error) {
 	params := map[string]interface{}{
 		"description": description,
 	}
 
 	path := fmt.Sprintf("/file/%d/", fileId)
-	err = client.RequestWithParams("PUT", path, nil, params, nil)
+	err = client.RequestWithParamsCtx(ctx, "PUT", path, nil, params, nil)
 	return
 }
@@ -0,0 +1,454 @@
+package podio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Keys of the filters that are not app fields
+const (
+	FilterAppItemId   = "app_item_id"
+	FilterCreatedBy   = "created_by"
+	FilterCreatedVia  = "created_via"
+	FilterCreatedOn   = "created_on"
+	FilterLastEditBy  = "last_edit_by"
+	FilterLastEditVia = "last_edit_via"
+	FilterLastEditOn  = "last_edit_on"
+	FilterTags        = "tags"
+)
+
+// Sort keys besides app fields
+const (
+	SortCreatedOn  = "created_on"
+	SortLastEditOn = "last_edit_on"
+	SortTitle      = "title"
+)
+
+// ItemFilter builds the params of FilterItems and its variants, see
+// https://developers.podio.com/doc/items/filter-items-4496747
+//
+//	params, err := podio.NewItemFilter().
+//		ForApp(app).
+//		Categories("status", 1, 2).
+//		Dates("deadline", "-7d", "").
+//		LastEditOn("-1m", "").
+//		SortBy(podio.SortCreatedOn, true).
+//		Limit(100).
+//		Params()
+//	items, err := client.FilterItemsCtx(ctx, app.Id, params)
+//
+// Fields are given by external id or by field id, see FieldKey. Filters of the same field are
+// combined: the ids of list filters are joined, e.g. two Categories calls match the options of
+// both, and AtLeast and AtMost make one range. Other repeated filters make Params fail. Without
+// ForApp a field given once by external id and once by field id is sent as two filters.
+type ItemFilter struct {
+	app        *App
+	sortBy     string
+	sortDesc   *bool
+	limit      int
+	offset     int
+	remember   bool
+	conditions []filterCondition
+	err        error
+}
+
+type filterCondition struct {
+	key   string
+	kind  filterKind
+	value interface{}
+}
+
+// filterKind says which field types a condition works with
+type filterKind string
+
+const (
+	filterKindCategory filterKind = "category"
+	filterKindContact  filterKind = "contact"
+	filterKindApp      filterKind = "app"
+	filterKindNumber   filterKind = "number"
+	filterKindDate     filterKind = "date"
+	filterKindRaw      filterKind = "raw"
+)
+
+var filterKindFieldTypes = map[filterKind][]string{
+	filterKindCategory: {"category", "question"},
+	filterKindContact:  {"contact"},
+	filterKindApp:      {"app"},
+	filterKindNumber:   {"number", "money", "progress", "duration", "calculation"},
+	filterKindDate:     {"date", "calculation"},
+}
+
+// calculationReturnTypes are the return types a calculation needs for a filter kind
+var calculationReturnTypes = map[filterKind][]string{
+	filterKindNumber: {"number", "money", "duration"},
+	filterKindDate:   {"date"},
+}
+
+var builtinFilterKeys = map[string]bool{
+	FilterAppItemId:   true,
+	FilterCreatedBy:   true,
+	FilterCreatedVia:  true,
+	FilterCreatedOn:   true,
+	FilterLastEditBy:  true,
+	FilterLastEditVia: true,
+	FilterLastEditOn:  true,
+	FilterTags:        true,
+}
+
+func NewItemFilter() *ItemFilter {
+	return &ItemFilter{}
+}
+
+// ForApp makes Params check the filters against the fields of app
+func (f *ItemFilter) ForApp(app *App) *ItemFilter {
+	f.app = app
+	return f
+}
+
+// SortBy sorts by an app field or one of the Sort keys
+func (f *ItemFilter) SortBy(key string, desc bool) *ItemFilter {
+	f.sortBy = key
+	f.sortDesc = &desc
+	return f
+}
+
+// Limit is the number of items to return, Podio allows at most 500
+func (f *ItemFilter) Limit(limit int) *ItemFilter {
+	f.limit = limit
+	return f
+}
+
+func (f *ItemFilter) Offset(offset int) *ItemFilter {
+	f.offset = offset
+	return f
+}
+
+// Remember stores the filter as the last used filter of the user on the app
+func (f *ItemFilter) Remember(remember bool) *ItemFilter {
+	f.remember = remember
+	return f
+}
+
+func (f *ItemFilter) add(key string, kind filterKind, value interface{}) *ItemFilter {
+	f.conditions = append(f.conditions, filterCondition{key: key, kind: kind, value: value})
+	return f
+}
+
+func (f *ItemFilter) fail(err error) *ItemFilter {
+	if f.err == nil {
+		f.err = err
+	}
+	return f
+}
+
+// Categories matches items with one of the options of a category or question field
+func (f *ItemFilter) Categories(field string, optionIds ...int) *ItemFilter {
+	return f.add(field, filterKindCategory, optionIds)
+}
+
+// Contacts matches items referencing one of the profiles in a contact field
+func (f *ItemFilter) Contacts(field string, profileIds ...ProfileID) *ItemFilter {
+	return f.add(field, filterKindContact, profileIds)
+}
+
+// AppRefs matches items referencing one of the items in an app reference field
+func (f *ItemFilter) AppRefs(field string, itemIds ...ItemID) *ItemFilter {
+	return f.add(field, filterKindApp, itemIds)
+}
+
+// Between matches number, money, progress, duration fields and calculations returning numbers
+// from from to to (inclusive)
+func (f *ItemFilter) Between(field string, from, to float64) *ItemFilter {
+	return f.add(field, filterKindNumber, numberRange{From: &from, To: &to})
+}
+
+// AtLeast matches number like fields with a value of at least from
+func (f *ItemFilter) AtLeast(field string, from float64) *ItemFilter {
+	return f.add(field, filterKindNumber, numberRange{From: &from})
+}
+
+// AtMost matches number like fields with a value of at most to
+func (f *ItemFilter) AtMost(field string, to float64) *ItemFilter {
+	return f.add(field, filterKindNumber, numberRange{To: &to})
+}
+
+// Dates matches date fields (and calculations returning dates) in a range. from and to are
+// dates like "2024-01-31", date times like "2024-01-31 12:00:00" or relative dates like "-7d",
+// "+2w", "-1m" or "+1y". An empty string leaves that end of the range open.
+func (f *ItemFilter) Dates(field string, from, to string) *ItemFilter {
+	r, err := newDateRange(from, to)
+	if err != nil {
+		return f.fail(fmt.Errorf("podio: filter %s: %w", field, err))
+	}
+	return f.add(field, filterKindDate, r)
+}
+
+// DatesBetween matches date fields between two times, a zero time leaves that end open
+func (f *ItemFilter) DatesBetween(field string, from, to time.Time) *ItemFilter {
+	return f.add(field, filterKindDate, dateRange{From: formatFilterTime(from), To: formatFilterTime(to)})
+}
+
+// AppItemIds matches items with an app item id from from to to (inclusive)
+func (f *ItemFilter) AppItemIds(from, to int) *ItemFilter {
+	return f.add(FilterAppItemId, filterKindRaw, map[string]int{"from": from, "to": to})
+}
+
+// CreatedBy matches items created by one of the refs, e.g. UserFilterRef(1)
+func (f *ItemFilter) CreatedBy(refs ...FilterRef) *ItemFilter {
+	return f.add(FilterCreatedBy, filterKindRaw, refs)
+}
+
+// CreatedVia matches items created through one of the given apps (client ids)
+func (f *ItemFilter) CreatedVia(viaIds ...int64) *ItemFilter {
+	return f.add(FilterCreatedVia, filterKindRaw, viaIds)
+}
+
+// CreatedOn matches items created in the range, see Dates for the format of from and to
+func (f *ItemFilter) CreatedOn(from, to string) *ItemFilter {
+	return f.dateKey(FilterCreatedOn, from, to)
+}
+
+// LastEditBy matches items last edited by one of the refs
+func (f *ItemFilter) LastEditBy(refs ...FilterRef) *ItemFilter {
+	return f.add(FilterLastEditBy, filterKindRaw, refs)
+}
+
+// LastEditVia matches items last edited through one of the given apps (client ids)
+func (f *ItemFilter) LastEditVia(viaIds ...int64) *ItemFilter {
+	return f.add(FilterLastEditVia, filterKindRaw, viaIds)
+}
+
+// LastEditOn matches items last edited in the range, see Dates for the format of from and to
+func (f *ItemFilter) LastEditOn(from, to string) *ItemFilter {
+	return f.dateKey(FilterLastEditOn, from, to)
+}
+
+// Tags matches items with one of the tags
+func (f *ItemFilter) Tags(tags ...string) *ItemFilter {
+	return f.add(FilterTags, filterKindRaw, tags)
+}
+
+// Key adds a filter as is, for keys without a typed method
+func (f *ItemFilter) Key(key string, value interface{}) *ItemFilter {
+	return f.add(key, filterKindRaw, value)
+}
+
+func (f *ItemFilter) dateKey(key, from, to string) *ItemFilter {
+	r, err := newDateRange(from, to)
+	if err != nil {
+		return f.fail(fmt.Errorf("podio: filter %s: %w", key, err))
+	}
+	return f.add(key, filterKindRaw, r)
+}
+
+// FilterRef is a user, app or other object in the created_by and last_edit_by filters
+type FilterRef struct {
+	Type string `json:"type"`
+	Id   int64  `json:"id"`
+}
+
+func UserFilterRef(userId UserID) FilterRef {
+	return FilterRef{Type: "user", Id: int64(userId)}
+}
+
+func AppFilterRef(appId AppID) FilterRef {
+	return FilterRef{Type: "app", Id: int64(appId)}
+}
+
+type numberRange struct {
+	From *float64 `json:"from,omitempty"`
+	To   *float64 `json:"to,omitempty"`
+}
+
+type dateRange struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+var (
+	relativeDate = regexp.MustCompile(`^[+-]?\d+[dwmy]$`)
+	absoluteDate = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}( \d{2}:\d{2}(:\d{2})?)?$`)
+)
+
+func newDateRange(from, to string) (dateRange, error) {
+	for _, bound := range []string{from, to} {
+		if bound != "" && !relativeDate.MatchString(bound) && !absoluteDate.MatchString(bound) {
+			return dateRange{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD, YYYY-MM-DD HH:MM:SS or a relative date like -7d", bound)
+		}
+	}
+	if from == "" && to == "" {
+		return dateRange{}, errors.New("date range needs a from or a to")
+	}
+	return dateRange{From: from, To: to}, nil
+}
+
+func formatFilterTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02 15:04:05")
+}
+
+// Validate checks the filters against the fields of app: every field must exist
+// and have a type the filter works with, for calculations the type they return
+func (f *ItemFilter) Validate(app *App) error {
+	if f.err != nil {
+		return f.err
+	}
+	for _, condition := range f.conditions {
+		if condition.kind == filterKindRaw || builtinFilterKeys[condition.key] {
+			continue
+		}
+		field := app.field(condition.key)
+		if field == nil {
+			return fmt.Errorf("podio: app %d has no field %q to filter on", app.Id, condition.key)
+		}
+		if !contains(filterKindFieldTypes[condition.kind], field.Type) {
+			return fmt.Errorf("podio: cannot use a %s filter on field %q of type %s", condition.kind, condition.key, field.Type)
+		}
+		if field.Type == "calculation" {
+			// without settings, e.g. an app fetched with a reduced view, the return type is unknown
+			returnType := calculationReturnType(field)
+			if returnType != "" && !contains(calculationReturnTypes[condition.kind], returnType) {
+				return fmt.Errorf("podio: cannot use a %s filter on calculation %q returning %s", condition.kind, condition.key, returnType)
+			}
+		}
+	}
+	if f.sortBy != "" && f.sortBy != SortCreatedOn && f.sortBy != SortLastEditOn && f.sortBy != SortTitle && app.field(f.sortBy) == nil {
+		return fmt.Errorf("podio: app %d has no field %q to sort by", app.Id, f.sortBy)
+	}
+	return nil
+}
+
+func calculationReturnType(field *AppField) string {
+	var settings FieldSettingsSimple
+	if field.Config.Settings != nil {
+		json.Unmarshal(*field.Config.Settings, &settings)
+	}
+	return settings.ReturnType
+}
+
+// Params returns the params for FilterItems, checked against the app given to ForApp
+func (f *ItemFilter) Params() (map[string]interface{}, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.app != nil {
+		if err := f.Validate(f.app); err != nil {
+			return nil, err
+		}
+	}
+	if f.limit < 0 || f.limit > 500 {
+		return nil, fmt.Errorf("podio: filter limit must be between 0 and 500, got %d", f.limit)
+	}
+
+	filters := map[string]interface{}{}
+	fieldKeys := map[FieldID]string{} // with ForApp, the key a field was first filtered by
+	for _, condition := range f.conditions {
+		key := condition.key
+		if f.app != nil && !builtinFilterKeys[key] {
+			if field := f.app.field(key); field != nil {
+				if first, ok := fieldKeys[field.Id]; ok {
+					key = first
+				} else {
+					fieldKeys[field.Id] = key
+				}
+			}
+		}
+		value, err := mergeFilterValues(key, filters[key], condition.value)
+		if err != nil {
+			return nil, err
+		}
+		filters[key] = value
+	}
+
+	params := map[string]interface{}{"filters": filters}
+	if f.sortBy != "" {
+		params["sort_by"] = f.sortBy
+	}
+	if f.sortDesc != nil {
+		params["sort_desc"] = *f.sortDesc
+	}
+	if f.limit > 0 {
+		params["limit"] = f.limit
+	}
+	if f.offset > 0 {
+		params["offset"] = f.offset
+	}
+	if f.remember {
+		params["remember"] = true
+	}
+	return params, nil
+}
+
+// isFilterRequest reports POST requests that only read, e.g. /item/app/{id}/filter/
+func isFilterRequest(method string, path string) bool {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	return method == http.MethodPost && strings.Contains(path, "/filter")
+}
+
+// mergeFilterValues combines two filters of the same field: lists of ids, refs or tags are
+// joined and a range with only a from is joined with a range with only a to
+func mergeFilterValues(key string, existing, value interface{}) (interface{}, error) {
+	if existing == nil {
+		return value, nil
+	}
+	switch a := existing.(type) {
+	case numberRange:
+		if b, ok := value.(numberRange); ok && (a.From == nil || b.From == nil) && (a.To == nil || b.To == nil) {
+			if a.From == nil {
+				a.From = b.From
+			}
+			if a.To == nil {
+				a.To = b.To
+			}
+			return a, nil
+		}
+	case dateRange:
+		if b, ok := value.(dateRange); ok && (a.From == "" || b.From == "") && (a.To == "" || b.To == "") {
+			if a.From == "" {
+				a.From = b.From
+			}
+			if a.To == "" {
+				a.To = b.To
+			}
+			return a, nil
+		}
+	default:
+		list, more := reflect.ValueOf(existing), reflect.ValueOf(value)
+		if list.Kind() == reflect.Slice && list.Type() == more.Type() {
+			merged := reflect.MakeSlice(list.Type(), 0, list.Len()+more.Len())
+			return reflect.AppendSlice(reflect.AppendSlice(merged, list), more).Interface(), nil
+		}
+	}
+	return nil, fmt.Errorf("podio: cannot combine the filters of %q, give its values or range in one filter", key)
+}
+
+// field finds a field by external id or by field id as a decimal string
+func (app *App) field(key string) *AppField {
+	for i := range app.Fields {
+		field := &app.Fields[i]
+		if field.ExternalId == key || strconv.FormatInt(int64(field.Id), 10) == key {
+			return field
+		}
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
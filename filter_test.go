@@ -0,0 +1,94 @@
+package podio
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func filterTestApp() *App {
+	return &App{
+		Id: 1,
+		Fields: []AppField{
+			{Id: 10, ExternalId: "status", Type: "category"},
+			{Id: 11, ExternalId: "deadline", Type: "date"},
+			{Id: 12, ExternalId: "amount", Type: "money"},
+			{Id: 13, ExternalId: "title", Type: "text"},
+		},
+	}
+}
+
+func TestItemFilterParams(t *testing.T) {
+	r := require.New(t)
+
+	params, err := NewItemFilter().
+		ForApp(filterTestApp()).
+		Categories("status", 1, 2).
+		Dates("deadline", "-7d", "").
+		AtLeast("12", 100).
+		LastEditOn("2024-01-01", "+0d").
+		CreatedBy(UserFilterRef(5)).
+		SortBy(SortCreatedOn, true).
+		Limit(100).
+		Offset(200).
+		Remember(true).
+		Params()
+	r.NoError(err)
+
+	buf, err := json.Marshal(params)
+	r.NoError(err)
+	r.Equal(`{"filters":{"12":{"from":100},"created_by":[{"type":"user","id":5}],"deadline":{"from":"-7d"},"last_edit_on":{"from":"2024-01-01","to":"+0d"},"status":[1,2]},"limit":100,"offset":200,"remember":true,"sort_by":"created_on","sort_desc":true}`, string(buf))
+}
+
+func TestItemFilterValidate(t *testing.T) {
+	r := require.New(t)
+	app := filterTestApp()
+
+	r.NoError(NewItemFilter().Tags("a").Key("title", "x").Validate(app))
+	r.ErrorContains(NewItemFilter().Categories("missing", 1).Validate(app), `no field "missing"`)
+	r.ErrorContains(NewItemFilter().Categories("deadline", 1).Validate(app), `field "deadline" of type date`)
+	r.ErrorContains(NewItemFilter().Between("title", 1, 2).Validate(app), "type text")
+	r.ErrorContains(NewItemFilter().SortBy("missing", false).Validate(app), "sort by")
+
+	numberSettings := json.RawMessage(`{"return_type": "number"}`)
+	dateSettings := json.RawMessage(`{"return_type": "date"}`)
+	app.Fields = append(app.Fields,
+		AppField{Id: 14, ExternalId: "total", Type: "calculation", Config: FieldConfig{Settings: &numberSettings}},
+		AppField{Id: 15, ExternalId: "due", Type: "calculation", Config: FieldConfig{Settings: &dateSettings}},
+		AppField{Id: 16, ExternalId: "unknown", Type: "calculation"},
+	)
+	r.NoError(NewItemFilter().Between("total", 1, 2).Dates("due", "-7d", "").Validate(app))
+	r.ErrorContains(NewItemFilter().AtLeast("due", 1).Validate(app), `calculation "due" returning date`)
+	r.ErrorContains(NewItemFilter().Dates("total", "-7d", "").Validate(app), `calculation "total" returning number`)
+	r.NoError(NewItemFilter().AtLeast("unknown", 1).Validate(app), "without settings the return type can't be checked")
+
+	_, err := NewItemFilter().Dates("deadline", "last week", "").Params()
+	r.ErrorContains(err, `invalid date "last week"`)
+
+	_, err = NewItemFilter().Limit(501).Params()
+	r.Error(err)
+}
+
+func TestItemFilterRepeatedFields(t *testing.T) {
+	r := require.New(t)
+
+	params, err := NewItemFilter().
+		ForApp(filterTestApp()).
+		Categories("status", 1).
+		Categories(FieldKey(10), 2).
+		AtLeast("amount", 10).
+		AtMost("amount", 20).
+		Tags("a").
+		Tags("b").
+		Params()
+	r.NoError(err)
+	buf, err := json.Marshal(params["filters"])
+	r.NoError(err)
+	r.Equal(`{"amount":{"from":10,"to":20},"status":[1,2],"tags":["a","b"]}`, string(buf))
+
+	_, err = NewItemFilter().AtLeast("amount", 10).AtLeast("amount", 20).Params()
+	r.ErrorContains(err, `cannot combine the filters of "amount"`)
+	_, err = NewItemFilter().Categories("status", 1).Dates("status", "-1d", "").Params()
+	r.ErrorContains(err, `cannot combine the filters of "status"`)
+}
@@ -1,17 +1,18 @@
 package podio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
 
 type Form struct {
-	Id      int   `json:"form_id"`
-	AppID   int64 `json:"app_id"`
-	SpaceID int64 `json:"space_id"`
+	Id      int     `json:"form_id"`
+	AppID   AppID   `json:"app_id"`
+	SpaceID SpaceID `json:"space_id"`
 
 	Fields   []FormField `json:"fields"`
-	FieldIDs []int64     `json:"field_ids"`
+	FieldIDs []FieldID   `json:"field_ids"`
 
 	Settings    FormSettings `json:"settings"`
 	Attachments bool         `json:"attachments"`
@@ -36,13 +37,18 @@ type FormTextSettings struct {
 }
 
 type FormField struct {
-	FieldID  int64           `json:"field_id"`
+	FieldID  FieldID         `json:"field_id"`
 	Settings json.RawMessage `json:"settings"` // to discover what values this can hold
 }
 
 // https://developers.podio.com/doc/forms/get-forms-53771
 func (client *Client) GetForms(appId int64) (forms []*Form, err error) {
+	return client.GetFormsCtx(context.Background(), AppID(appId))
+}
+
+// GetFormsCtx is the context-aware version of GetForms.
+func (client *Client) GetFormsCtx(ctx context.Context, appId AppID) (forms []*Form, err error) {
 	path := fmt.Sprintf("/form/app/%d", appId)
-	err = client.Request("GET", path, nil, nil, &forms)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &forms)
 	return
 }
@@ -1,6 +1,9 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type GrantResponse struct {
 	Invitable []Contact `json:"invitable"`
@@ -8,7 +11,12 @@ type GrantResponse struct {
 
 // https://developers.podio.com/doc/grants/create-grant-16168841
 func (client *Client) CreateGrant(refType string, refId int64, params map[string]interface{}) (g GrantResponse, err error) {
+	return client.CreateGrantCtx(context.Background(), refType, refId, params)
+}
+
+// CreateGrantCtx is the context-aware version of CreateGrant.
+func (client *Client) CreateGrantCtx(ctx context.Context, refType string, refId int64, params map[string]interface{}) (g GrantResponse, err error) {
 	path := fmt.Sprintf("/grant/%s/%d", refType, refId)
-	err = client.RequestWithParams("POST", path, nil, params, &g)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &g)
 	return
 }
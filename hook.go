@@ -1,7 +1,10 @@
 package podio
 
-import "encoding/json"
-import "fmt"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
 
 type Hook struct {
 	Id     int64  `json:"hook_id"`
@@ -11,58 +14,101 @@ type Hook struct {
 }
 
 // https://developers.podio.com/doc/hooks/create-hook-215056
+//
+// Deprecated: use CreateHookCtx with CaptureResponseWithBody to also get the raw JSON.
 func (client *Client) CreateHookJson(refType string, refId int64, url string, hookType string) (rawResponse *json.RawMessage, err error) {
+	return client.CreateHookJsonCtx(context.Background(), refType, refId, url, hookType)
+}
+
+// CreateHookJsonCtx is the context-aware version of CreateHookJson.
+//
+// Deprecated: use CreateHookCtx with CaptureResponseWithBody to also get the raw JSON.
+func (client *Client) CreateHookJsonCtx(ctx context.Context, refType string, refId int64, url string, hookType string) (rawResponse *json.RawMessage, err error) {
   path := fmt.Sprintf("/hook/%s/%d", refType, refId)
   params := map[string]interface{}{
 		"url": url,
     "type": hookType,
 	}
-  err = client.RequestWithParams("POST", path, nil, params, &rawResponse)
+  err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &rawResponse)
 	return
 }
 
 // https://developers.podio.com/doc/hooks/create-hook-215056
 func (client *Client) CreateHook(refType string, refId int64, url string, hookType string) (hook Hook, err error) {
+	return client.CreateHookCtx(context.Background(), refType, refId, url, hookType)
+}
+
+// CreateHookCtx is the context-aware version of CreateHook.
+func (client *Client) CreateHookCtx(ctx context.Context, refType string, refId int64, url string, hookType string) (hook Hook, err error) {
   path := fmt.Sprintf("/hook/%s/%d", refType, refId)
   params := map[string]interface{}{
 		"url": url,
     "type": hookType,
 	}
-  err = client.RequestWithParams("POST", path, nil, params, &hook)
+  err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &hook)
 	return
 }
 
 // https://developers.podio.com/doc/hooks/request-hook-verification-215232
 func (client *Client) VerifyHook(hookId int64) error {
+	return client.VerifyHookCtx(context.Background(), hookId)
+}
+
+// VerifyHookCtx is the context-aware version of VerifyHook.
+func (client *Client) VerifyHookCtx(ctx context.Context, hookId int64) error {
   path := fmt.Sprintf("/hook/%d/verify/request", hookId)
-  return client.Request("POST", path, nil, nil, nil)
+  return client.RequestCtx(ctx, "POST", path, nil, nil, nil)
 }
 
 // https://developers.podio.com/doc/hooks/validate-hook-verification-215241
 func (client *Client) ValidateHook(hookId int64, code string) error {
+	return client.ValidateHookCtx(context.Background(), hookId, code)
+}
+
+// ValidateHookCtx is the context-aware version of ValidateHook.
+func (client *Client) ValidateHookCtx(ctx context.Context, hookId int64, code string) error {
   path := fmt.Sprintf("/hook/%d/verify/validate", hookId)
   params := map[string]interface{}{
 		"code": code,
 	}
-  return client.RequestWithParams("POST", path, nil, params, nil)
+  return client.RequestWithParamsCtx(ctx, "POST", path, nil, params, nil)
 }
 
 // https://developers.podio.com/doc/hooks/delete-hook-215291
 func (client *Client) DeleteHook(hookId int64) error {
+	return client.DeleteHookCtx(context.Background(), hookId)
+}
+
+// DeleteHookCtx is the context-aware version of DeleteHook.
+func (client *Client) DeleteHookCtx(ctx context.Context, hookId int64) error {
   path := fmt.Sprintf("/hook/%d", hookId)
-  return client.Request("DELETE", path, nil, nil, nil)
+  return client.RequestCtx(ctx, "DELETE", path, nil, nil, nil)
 }
 
 // https://developers.podio.com/doc/hooks/get-hooks-215285
+//
+// Deprecated: use FindHooksCtx with CaptureResponseWithBody to also get the raw JSON.
 func (client *Client) FindHooksJson(refType string, refId int64) (rawResponse *json.RawMessage, err error) {
+	return client.FindHooksJsonCtx(context.Background(), refType, refId)
+}
+
+// FindHooksJsonCtx is the context-aware version of FindHooksJson.
+//
+// Deprecated: use FindHooksCtx with CaptureResponseWithBody to also get the raw JSON.
+func (client *Client) FindHooksJsonCtx(ctx context.Context, refType string, refId int64) (rawResponse *json.RawMessage, err error) {
   path := fmt.Sprintf("/hook/%s/%d", refType, refId)
-  err = client.Request("GET", path, nil, nil, &rawResponse)
+  err = client.RequestCtx(ctx, "GET", path, nil, nil, &rawResponse)
 	return
 }
 
 // https://developers.podio.com/doc/hooks/get-hooks-215285
 func (client *Client) FindHooks(refType string, refId int64) (hooks []Hook, err error) {
+	return client.FindHooksCtx(context.Background(), refType, refId)
+}
+
+// FindHooksCtx is the context-aware version of FindHooks.
+func (client *Client) FindHooksCtx(ctx context.Context, refType string, refId int64) (hooks []Hook, err error) {
   path := fmt.Sprintf("/hook/%s/%d", refType, refId)
-  err = client.Request("GET", path, nil, nil, &hooks)
+  err = client.RequestCtx(ctx, "GET", path, nil, nil, &hooks)
 	return
 }
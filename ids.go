@@ -0,0 +1,16 @@
+package podio
+
+// Named id types, so ids of different objects cannot be mixed up. They are all
+// int64 like the ids in the Podio API and used by the structs, the context-aware
+// methods (e.g. GetItemCtx) and the services. The methods without context keep
+// their old int and int64 parameters for compatibility, the struct fields changed
+// type, see "Migrating to typed IDs" in the README.
+type (
+	ItemID    int64
+	AppID     int64
+	FieldID   int64
+	FileID    int64
+	SpaceID   int64
+	ProfileID int64
+	UserID    int64
+)
@@ -1,6 +1,9 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type BatchIDResp struct {
 	Id int64 `json:"batch_id"`
@@ -8,9 +11,14 @@ type BatchIDResp struct {
 
 // https://developers.podio.com/doc/importer/import-app-items-212899
 func (client *Client) Importer(appId int64, fileId int, params map[string]interface{}) (batchID int64, err error) {
+	return client.ImporterCtx(context.Background(), AppID(appId), FileID(fileId), params)
+}
+
+// ImporterCtx is the context-aware version of Importer.
+func (client *Client) ImporterCtx(ctx context.Context, appId AppID, fileId FileID, params map[string]interface{}) (batchID int64, err error) {
 	path := fmt.Sprintf("/importer/%d/item/app/%d", fileId, appId)
 	var r BatchIDResp
-	err = client.RequestWithParams("POST", path, nil, params, &r)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &r)
 	batchID = r.Id
 	return
 }
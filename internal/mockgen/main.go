@@ -0,0 +1,157 @@
+// Command mockgen writes the mocks in podiomock for the service interfaces of the podio package.
+//
+//	go run ./internal/mockgen -source services.go -out podiomock/mocks.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	source := flag.String("source", "services.go", "file with the service interfaces")
+	out := flag.String("out", "podiomock/mocks.go", "file to write the mocks to")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, *source, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			iface, ok := typeSpec.Type.(*ast.InterfaceType)
+			if !ok || !strings.HasSuffix(typeSpec.Name.Name, "Service") {
+				continue
+			}
+			writeMock(&b, fset, typeSpec.Name.Name, iface)
+		}
+	}
+
+	body := b.String()
+	imports := []string{`"context"`}
+	if strings.Contains(body, "json.") {
+		imports = append(imports, `"encoding/json"`)
+	}
+	if strings.Contains(body, "iter.") {
+		imports = append(imports, `"iter"`)
+	}
+	imports = append(imports, `"sync"`, "", `"github.com/andreas/podio-go"`)
+
+	src := "// Code generated by internal/mockgen. DO NOT EDIT.\n\npackage podiomock\n\nimport (\n\t" +
+		strings.Join(imports, "\n\t") + "\n)\n\n" + body
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		log.Fatalf("%v\n%s", err, src)
+	}
+	if err := os.WriteFile(*out, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeMock(b *bytes.Buffer, fset *token.FileSet, name string, iface *ast.InterfaceType) {
+	fmt.Fprintf(b, "// %s is a mock of podio.%s. Set the func of every method the code under test\n", name, name)
+	fmt.Fprintf(b, "// calls, calling a method without a func panics.\n")
+	fmt.Fprintf(b, "type %s struct {\n", name)
+	for _, method := range iface.Methods.List {
+		fmt.Fprintf(b, "\t%sFunc %s\n", method.Names[0].Name, typeString(fset, method.Type))
+	}
+	fmt.Fprintf(b, "\n\tmu sync.Mutex\n\tcalls []Call\n}\n\n")
+	fmt.Fprintf(b, "var _ podio.%s = (*%s)(nil)\n\n", name, name)
+
+	fmt.Fprintf(b, "// Calls returns the calls made so far in order\n")
+	fmt.Fprintf(b, "func (m *%s) Calls() []Call {\n\tm.mu.Lock()\n\tdefer m.mu.Unlock()\n\treturn append([]Call(nil), m.calls...)\n}\n\n", name)
+
+	for _, method := range iface.Methods.List {
+		methodName := method.Names[0].Name
+		funcType := method.Type.(*ast.FuncType)
+
+		var args, forward []string
+		for _, param := range funcType.Params.List {
+			_, variadic := param.Type.(*ast.Ellipsis)
+			for _, paramName := range param.Names {
+				args = append(args, paramName.Name)
+				if variadic {
+					forward = append(forward, paramName.Name+"...")
+				} else {
+					forward = append(forward, paramName.Name)
+				}
+			}
+		}
+		signature := strings.TrimPrefix(typeString(fset, funcType), "func")
+
+		fmt.Fprintf(b, "func (m *%s) %s%s {\n", name, methodName, signature)
+		fmt.Fprintf(b, "\tm.mu.Lock()\n\tm.calls = append(m.calls, Call{Method: %q, Args: []interface{}{%s}})\n\tm.mu.Unlock()\n\n", methodName, strings.Join(args, ", "))
+		fmt.Fprintf(b, "\tif m.%sFunc == nil {\n\t\tpanic(\"podiomock: %s.%s called without %sFunc\")\n\t}\n", methodName, name, methodName, methodName)
+		if funcType.Results == nil {
+			fmt.Fprintf(b, "\tm.%sFunc(%s)\n}\n\n", methodName, strings.Join(forward, ", "))
+		} else {
+			fmt.Fprintf(b, "\treturn m.%sFunc(%s)\n}\n\n", methodName, strings.Join(forward, ", "))
+		}
+	}
+}
+
+// typeString prints a type of the podio package as seen from another package
+func typeString(fset *token.FileSet, expr ast.Expr) string {
+	qualified := ast.Expr(qualify(expr))
+	var b bytes.Buffer
+	printer.Fprint(&b, fset, qualified)
+	return b.String()
+}
+
+func qualify(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(e.Name) {
+			return &ast.SelectorExpr{X: ast.NewIdent("podio"), Sel: ast.NewIdent(e.Name)}
+		}
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key), Value: qualify(e.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(e.Elt)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: e.X, Index: qualify(e.Index)}
+	case *ast.IndexListExpr:
+		indices := make([]ast.Expr, len(e.Indices))
+		for i, index := range e.Indices {
+			indices[i] = qualify(index)
+		}
+		return &ast.IndexListExpr{X: e.X, Indices: indices}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: qualifyFields(e.Params), Results: qualifyFields(e.Results)}
+	}
+	// selectors like context.Context and interface{} stay as they are
+	return expr
+}
+
+func qualifyFields(fields *ast.FieldList) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	qualified := &ast.FieldList{}
+	for _, field := range fields.List {
+		qualified.List = append(qualified.List, &ast.Field{Names: field.Names, Type: qualify(field.Type)})
+	}
+	return qualified
+}
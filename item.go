@@ -1,9 +1,12 @@
 package podio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -12,24 +15,29 @@ import (
 
 // Item describes a Podio item object
 type Item struct {
-	Id                 int64    `json:"item_id"`
+	Id                 ItemID   `json:"item_id"`
 	AppItemId          int      `json:"app_item_id"`
 	FormattedAppItemId string   `json:"app_item_id_formatted"`
 	Title              string   `json:"title"`
-	Files              []*File  `json:"files"`
-	Fields             []*Field `json:"fields"`
-	Space              Space    `json:"space"`
-	App                App      `json:"app"`
-	CreatedVia         Via      `json:"created_via"`
-	CreatedBy          ByLine   `json:"by_line"`
+	Files              []*File  `json:"files,omitempty"`
+	Fields             []*Field `json:"fields,omitempty"`
+	Space              Space    `json:"space,omitzero"`
+	App                App      `json:"app,omitzero"`
+	CreatedVia         Via      `json:"created_via,omitzero"`
+	CreatedBy          ByLine   `json:"by_line,omitzero"`
 	CreatedOn          Time     `json:"created_on"`
 	Link               string   `json:"link"`
 	Revision           int      `json:"revision"`
-	Push               Push     `json:"push"`
+	Push               Push     `json:"push,omitzero"`
+
+	// Warnings lists the fields whose values could not be decoded, see WithDecodeMode
+	Warnings []*FieldDecodeError `json:"-"`
+
+	fieldIndex fieldIndex
 }
 
 type ItemSimple struct {
-	Id           int64    `json:"item_id"`
+	Id           ItemID   `json:"item_id"`
 	AppItemId    int      `json:"app_item_id"`
 	Title        string   `json:"title"`
 	Revision     int      `json:"revision"`
@@ -51,6 +59,11 @@ type ItemSimple struct {
 
 	// Files
 	Files []*File `json:"files"`
+
+	// Warnings lists the fields whose values could not be decoded, see WithDecodeMode
+	Warnings []*FieldDecodeError `json:"-"`
+
+	fieldIndex fieldIndex
 }
 
 type RefCount struct {
@@ -59,11 +72,11 @@ type RefCount struct {
 }
 
 type RefField struct {
-	FieldID int64 `json:"field_id"`
+	FieldID FieldID `json:"field_id"`
 }
 
 type ItemMicro struct {
-	Id         int64  `json:"item_id"`
+	Id         ItemID `json:"item_id"`
 	AppItemId  int    `json:"app_item_id"`
 	Title      string `json:"title"`
 	Revision   int    `json:"revision"`
@@ -71,7 +84,7 @@ type ItemMicro struct {
 }
 
 type ItemMini struct {
-	Id        int64  `json:"item_id"`
+	Id        ItemID `json:"item_id"`
 	AppItemId int    `json:"app_item_id"`
 	Title     string `json:"title"`
 	Revision  int    `json:"revision"`
@@ -89,15 +102,15 @@ type ItemReferences struct {
 }
 
 type ItemReference struct {
-	ItemID int64 `json:"item_id"`
+	ItemID ItemID `json:"item_id"`
 }
 
 type ItemReferenceApp struct {
-	AppID int64 `json:"app_id"`
+	AppID AppID `json:"app_id"`
 }
 
 type ItemReferenceField struct {
-	FieldID int64 `json:"field_id"`
+	FieldID FieldID `json:"field_id"`
 }
 
 // trick to get the "LastEditOn"
@@ -106,7 +119,7 @@ type RevisionInfo struct {
 }
 
 type AppSimple struct {
-	Id int64 `json:"app_id"`
+	Id AppID `json:"app_id"`
 }
 
 type ItemCount struct {
@@ -114,7 +127,7 @@ type ItemCount struct {
 }
 
 type itemId struct {
-	Id int64 `json:"item_id"`
+	Id ItemID `json:"item_id"`
 }
 
 // partialField is used for JSON unmarshalling
@@ -123,12 +136,12 @@ type itemId struct {
 //  2. when using AppField in elsa we want to keep Config > Settings as raw json
 //     as we can't parse for all different app fields
 type PartialField struct {
-	Id         int64             `json:"field_id"`
+	Id         FieldID           `json:"field_id"`
 	ExternalId string            `json:"external_id"`
 	Type       string            `json:"type"`
 	Label      string            `json:"label"`
 	ValuesJSON json.RawMessage   `json:"values"`
-	Config     FieldConfigSimple `json:"config"`
+	Config     FieldConfigSimple `json:"config,omitzero"`
 }
 
 type FieldConfigSimple struct {
@@ -143,11 +156,18 @@ type FieldSettingsSimple struct {
 type Field struct {
 	PartialField
 	Values interface{}
+
+	// warning is set for fields of unknown types, see WithDecodeMode
+	warning *FieldDecodeError
 }
 
 func (f *Field) unmarshalValuesInto(out interface{}) error {
+	if len(f.ValuesJSON) == 0 {
+		// e.g. fields in stream activities come without values
+		return nil
+	}
 	if err := json.Unmarshal(f.ValuesJSON, &out); err != nil {
-		return fmt.Errorf("[ERR] Cannot unmarshal %s into %s: %v\n", f.ValuesJSON, reflect.TypeOf(out), err)
+		return f.decodeError(fmt.Errorf("cannot unmarshal values into %s: %w", reflect.TypeOf(out), err))
 	}
 	return nil
 }
@@ -158,117 +178,201 @@ func (f *Field) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	f.UnmarshalValues()
+	return f.UnmarshalValues()
+}
+
+// MarshalJSON writes the field in the shape Podio sends it, with the values taken from Values
+// so a decoded item can be encoded and decoded again without losing values.
+func (f Field) MarshalJSON() ([]byte, error) {
+	partial := f.PartialField
+	if f.Values != nil {
+		values, err := json.Marshal(f.Values)
+		if err != nil {
+			return nil, fmt.Errorf("cannot marshal values of field %d (%s): %w", f.Id, f.ExternalId, err)
+		}
+		partial.ValuesJSON = values
+	}
+	return json.Marshal(partial)
+}
+
+// marshalOmitZero encodes the struct v, leaving out the fields tagged omitzero that hold
+// their zero value. encoding/json only knows the omitzero option since Go 1.24, so types
+// with such fields marshal through here, converted to a type without methods.
+func marshalOmitZero(v interface{}) ([]byte, error) {
+	value := reflect.ValueOf(v)
+	fields := []reflect.StructField{}
+	values := []reflect.Value{}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		_, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if slices.Contains(strings.Split(options, ","), "omitzero") && isZeroField(value.Field(i)) {
+			continue
+		}
+		fields = append(fields, reflect.StructField{Name: field.Name, Type: field.Type, Tag: field.Tag, Anonymous: field.Anonymous})
+		values = append(values, value.Field(i))
+	}
 
-	return nil
+	out := reflect.New(reflect.StructOf(fields)).Elem()
+	for i, v := range values {
+		out.Field(i).Set(v)
+	}
+	return json.Marshal(out.Interface())
+}
+
+// isZeroField follows omitzero: an IsZero method decides, otherwise the zero value
+func isZeroField(v reflect.Value) bool {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return true
+	}
+	if zeroer, ok := v.Interface().(interface{ IsZero() bool }); ok {
+		return zeroer.IsZero()
+	}
+	return v.IsZero()
+}
+
+func (item Item) MarshalJSON() ([]byte, error) {
+	type plain Item
+	return marshalOmitZero(plain(item))
+}
+
+func (f PartialField) MarshalJSON() ([]byte, error) {
+	type plain PartialField
+	return marshalOmitZero(plain(f))
+}
+
+func (v EmbedValue) MarshalJSON() ([]byte, error) {
+	type plain EmbedValue
+	return marshalOmitZero(plain(v))
 }
 
 // UnmarshalValues transforms a json.RawMessage message into actual podio types (App, Date, ...)
-func (f *Field) UnmarshalValues() {
+// Malformed values return a *FieldDecodeError.
+func (f *Field) UnmarshalValues() error {
+	var err error
+	f.warning = nil
 	switch f.Type {
 	case "app":
 		values := []AppValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "date":
 		values := []DateValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "text":
 		values := []TextValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "tag":
 		values := []TagValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "number":
 		values := []NumberValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "image":
 		values := []ImageValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "member":
 		values := []MemberValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "contact":
 		values := []ContactValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "money":
 		values := []MoneyValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "progress":
 		values := []ProgressValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "location":
 		values := []LocationValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "video":
 		values := []VideoValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "duration":
 		values := []DurationValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "embed":
 		values := []EmbedValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "question":
 		values := []QuestionValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "category":
 		values := []CategoryValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "tel":
 		values := []TelValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "phone":
 		values := []PhoneValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "email":
 		values := []EmailValue{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	case "calculation":
 		switch f.Config.Settings.ReturnType {
 		case "text":
 			values := []TextValue{}
-			f.unmarshalValuesInto(&values)
+			err = f.unmarshalValuesInto(&values)
 			f.Values = values
 
 		case "number":
 			values := []NumberValue{}
-			f.unmarshalValuesInto(&values)
+			err = f.unmarshalValuesInto(&values)
 			f.Values = values
 
 		case "date":
 			values := []DateValue{}
-			f.unmarshalValuesInto(&values)
+			err = f.unmarshalValuesInto(&values)
+			f.Values = values
+
+		case "money":
+			values := []MoneyValue{}
+			err = f.unmarshalValuesInto(&values)
+			f.Values = values
+
+		case "duration":
+			values := []DurationValue{}
+			err = f.unmarshalValuesInto(&values)
+			f.Values = values
+
+		default:
+			values := []CalculationValue{}
+			err = f.unmarshalValuesInto(&values)
 			f.Values = values
 		}
 
 	default:
-		// Unknown field type
-		fmt.Println("error=unknown_app_field context=podio_item level=notice type='", f.Type, "' field='", f, "'")
+		// Unknown field type, the client logs it or fails depending on its DecodeMode
+		f.warning = f.decodeError(ErrUnknownFieldType)
 		values := []interface{}{}
-		f.unmarshalValuesInto(&values)
+		err = f.unmarshalValuesInto(&values)
 		f.Values = values
 	}
 
 	f.ValuesJSON = nil
+	return err
 }
 
 // TextValue is the value for fields of type `text`
@@ -293,20 +397,30 @@ type ImageValue struct {
 
 type ImageAndItem struct {
 	File       File
-	ItemId     int64
-	AppFieldId int64
+	ItemId     ItemID
+	AppFieldId FieldID
 }
 
 type ImageValueSimple struct {
-	FileId int `json:"file_id"`
+	FileId FileID `json:"file_id"`
 }
 
-// DateValue is the value for fields of type `date`
+// DateValue is the value for fields of type `date`, see DateValue.In for the times it describes.
+// The date and time parts are null for the time of all-day dates and for a missing end.
 type DateValue struct {
 	Start    *Time   `json:"start"`
 	End      *Time   `json:"end"`
 	StartUTC *string `json:"start_utc"`
 	EndUTC   *string `json:"end_utc"`
+
+	StartDate    *string `json:"start_date,omitempty"`
+	StartTime    *string `json:"start_time,omitempty"`
+	EndDate      *string `json:"end_date,omitempty"`
+	EndTime      *string `json:"end_time,omitempty"`
+	StartDateUTC *string `json:"start_date_utc,omitempty"`
+	StartTimeUTC *string `json:"start_time_utc,omitempty"`
+	EndDateUTC   *string `json:"end_date_utc,omitempty"`
+	EndTimeUTC   *string `json:"end_time_utc,omitempty"`
 }
 
 type DateValueSimple struct {
@@ -325,8 +439,8 @@ type AppValue struct {
 }
 
 type AppValueSimple struct {
-	ItemId int64 `json:"item_id"`
-	AppId  int64 `json:"app_id"`
+	ItemId ItemID `json:"item_id"`
+	AppId  AppID  `json:"app_id"`
 }
 
 // MemberValue is the value for fields of type `member`
@@ -383,17 +497,20 @@ type DurationValue struct {
 // EmbedValue is the value for fields of type `embed`
 type EmbedValue struct {
 	Embed Embed `json:"embed"`
-	File  File  `json:"file"`
+	File  File  `json:"file,omitzero"`
 }
 
 // CategoryValue is the value for fields of type `category`
 type CategoryValue struct {
-	Value struct {
-		Status string `json:"status"`
-		Text   string `json:"text"`
-		Id     int    `json:"id"`
-		Color  string `json:"color"`
-	} `json:"value"`
+	Value CategoryOption `json:"value"`
+}
+
+// CategoryOption is an option of a category field
+type CategoryOption struct {
+	Status string `json:"status"`
+	Text   string `json:"text"`
+	Id     int    `json:"id"`
+	Color  string `json:"color"`
 }
 
 // QuestionValue is the value for fields of type `question`
@@ -417,9 +534,16 @@ type EmailValue struct {
 	Type  string `json:"type"`
 }
 
-// CalcationValue is the value for fields of type `calculation` (currently untyped)
+// CalculationValue is the value of calculations returning another type than text, number, date, money or duration
 type CalculationValue map[string]interface{}
 
+// partial responses of the item filters, see WithFields
+var (
+	itemsWithFilesAndTags    = Select("items").Fields(Select("files"), Select("tags"))
+	microItemsWithExternalId = Select("items").View(ViewMicro).Fields(Select("external_id"))
+	miniItems                = Select("items").View(ViewMini)
+)
+
 type ItemList struct {
 	Filtered int     `json:"filtered"`
 	Total    int     `json:"total"`
@@ -446,127 +570,221 @@ type ItemListMini struct {
 
 // https://developers.podio.com/doc/items/filter-items-4496747
 func (client *Client) GetItems(appId int64) (items *ItemList, err error) {
-	path := fmt.Sprintf("/item/app/%d/filter?fields=items.fields(files,tags)", appId)
-	err = client.Request("POST", path, nil, nil, &items)
+	return client.GetItemsCtx(context.Background(), AppID(appId))
+}
+
+// GetItemsCtx is the context-aware version of GetItems.
+func (client *Client) GetItemsCtx(ctx context.Context, appId AppID) (items *ItemList, err error) {
+	path := fmt.Sprintf("/item/app/%d/filter?fields=%s", appId, itemsWithFilesAndTags)
+	err = client.RequestCtx(ctx, "POST", path, nil, nil, &items)
 	return
 }
 
 // https://developers.podio.com/doc/items/filter-items-4496747
 func (client *Client) GetItemsSimple(appId int64) (items *ItemListSimple, err error) {
-	path := fmt.Sprintf("/item/app/%d/filter?fields=items.fields(files,tags)", appId)
-	err = client.Request("POST", path, nil, nil, &items)
+	return client.GetItemsSimpleCtx(context.Background(), AppID(appId))
+}
+
+// GetItemsSimpleCtx is the context-aware version of GetItemsSimple.
+func (client *Client) GetItemsSimpleCtx(ctx context.Context, appId AppID) (items *ItemListSimple, err error) {
+	path := fmt.Sprintf("/item/app/%d/filter?fields=%s", appId, itemsWithFilesAndTags)
+	err = client.RequestCtx(ctx, "POST", path, nil, nil, &items)
 	return
 }
 
 // https://developers.podio.com/doc/items/filter-items-4496747
 func (client *Client) FilterItems(appId int64, params map[string]interface{}) (items *ItemList, err error) {
-	path := fmt.Sprintf("/item/app/%d/filter?fields=items.fields(files,tags)", appId)
-	err = client.RequestWithParams("POST", path, nil, params, &items)
+	return client.FilterItemsCtx(context.Background(), AppID(appId), params)
+}
+
+// FilterItemsCtx is the context-aware version of FilterItems.
+func (client *Client) FilterItemsCtx(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemList, err error) {
+	path := fmt.Sprintf("/item/app/%d/filter?fields=%s", appId, itemsWithFilesAndTags)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &items)
 	return
 }
 
 // https://developers.podio.com/doc/items/filter-items-4496747
 func (client *Client) FilterItemsSimple(appId int64, params map[string]interface{}) (items *ItemListSimple, err error) {
-	path := fmt.Sprintf("/item/app/%d/filter?fields=items.fields(files,tags)", appId)
-	err = client.RequestWithParams("POST", path, nil, params, &items)
+	return client.FilterItemsSimpleCtx(context.Background(), AppID(appId), params)
+}
+
+// FilterItemsSimpleCtx is the context-aware version of FilterItemsSimple.
+func (client *Client) FilterItemsSimpleCtx(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemListSimple, err error) {
+	path := fmt.Sprintf("/item/app/%d/filter?fields=%s", appId, itemsWithFilesAndTags)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &items)
 	return
 }
 
 // https://developers.podio.com/doc/items/filter-items-4496747
+// fields is a raw selector, FilterItemsSimpleCtx with WithFields builds it instead.
 func (client *Client) FilterItemsSimpleWithCustomFields(appId int64, params map[string]interface{}, fields string) (items *ItemListSimple, err error) {
+	return client.FilterItemsSimpleWithCustomFieldsCtx(context.Background(), AppID(appId), params, fields)
+}
+
+// FilterItemsSimpleWithCustomFieldsCtx is the context-aware version of FilterItemsSimpleWithCustomFields.
+func (client *Client) FilterItemsSimpleWithCustomFieldsCtx(ctx context.Context, appId AppID, params map[string]interface{}, fields string) (items *ItemListSimple, err error) {
 	path := fmt.Sprintf("/item/app/%d/filter?fields=%s", appId, fields)
-	err = client.RequestWithParams("POST", path, nil, params, &items)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &items)
 	return
 }
 
 // https://developers.podio.com/doc/items/filter-items-4496747
 func (client *Client) FilterItemsMicro(appId int64, params map[string]interface{}) (items *ItemListMicro, err error) {
-	path := fmt.Sprintf("/item/app/%d/filter?fields=items.view(micro).fields(external_id)", appId)
-	err = client.RequestWithParams("POST", path, nil, params, &items)
+	return client.FilterItemsMicroCtx(context.Background(), AppID(appId), params)
+}
+
+// FilterItemsMicroCtx is the context-aware version of FilterItemsMicro.
+func (client *Client) FilterItemsMicroCtx(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemListMicro, err error) {
+	path := fmt.Sprintf("/item/app/%d/filter?fields=%s", appId, microItemsWithExternalId)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &items)
 	return
 }
 
 // https://developers.podio.com/doc/items/filter-items-4496747
+//
+// Deprecated: use FilterItemsMicroCtx with CaptureResponse.
 func (client *Client) FilterItemsMicroWithRateLimitStats(appId int64, params map[string]interface{}) (items *ItemListMicro, rateLimitRemaining, rateLimit int, err error) {
-	path := fmt.Sprintf("/item/app/%d/filter?fields=items.view(micro).fields(external_id)", appId)
-	rateLimitRemaining, rateLimit, err = client.requestWithParamsAndRemainingLimit("POST", path, nil, params, &items)
-	return
+	return client.FilterItemsMicroWithRateLimitStatsCtx(context.Background(), AppID(appId), params)
+}
+
+// FilterItemsMicroWithRateLimitStatsCtx is the context-aware version of FilterItemsMicroWithRateLimitStats.
+//
+// Deprecated: use FilterItemsMicroCtx with CaptureResponse.
+func (client *Client) FilterItemsMicroWithRateLimitStatsCtx(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemListMicro, rateLimitRemaining, rateLimit int, err error) {
+	var resp Response
+	items, err = client.FilterItemsMicroCtx(CaptureResponse(ctx, &resp), appId, params)
+	return items, resp.RateLimitRemaining, resp.RateLimit, err
 }
 
 // https://developers.podio.com/doc/items/filter-items-4496747
 func (client *Client) FilterItemsMini(appId int64, params map[string]interface{}) (items *ItemListMini, err error) {
-	path := fmt.Sprintf("/item/app/%d/filter?fields=items.view(mini)", appId)
-	err = client.RequestWithParams("POST", path, nil, params, &items)
+	return client.FilterItemsMiniCtx(context.Background(), AppID(appId), params)
+}
+
+// FilterItemsMiniCtx is the context-aware version of FilterItemsMini.
+func (client *Client) FilterItemsMiniCtx(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemListMini, err error) {
+	path := fmt.Sprintf("/item/app/%d/filter?fields=%s", appId, miniItems)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &items)
 	return
 }
 
 // https://developers.podio.com/doc/items/filter-items-4496747
+//
+// Deprecated: use FilterItemsCtx with CaptureResponseWithBody to also get the raw JSON.
 func (client *Client) FilterItemsJson(appId int64, params map[string]interface{}) (rawResponse *json.RawMessage, err error) {
-	path := fmt.Sprintf("/item/app/%d/filter?fields=items.fields(files,tags)", appId)
-	err = client.RequestWithParams("POST", path, nil, params, &rawResponse)
+	return client.FilterItemsJsonCtx(context.Background(), AppID(appId), params)
+}
+
+// FilterItemsJsonCtx is the context-aware version of FilterItemsJson.
+//
+// Deprecated: use FilterItemsCtx with CaptureResponseWithBody to also get the raw JSON.
+func (client *Client) FilterItemsJsonCtx(ctx context.Context, appId AppID, params map[string]interface{}) (rawResponse *json.RawMessage, err error) {
+	path := fmt.Sprintf("/item/app/%d/filter?fields=%s", appId, itemsWithFilesAndTags)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &rawResponse)
 	return
 }
 
 // https://developers.podio.com/doc/items/export-items-4235696
 func (client *Client) ExportItems(appId int64, exportFormat string, params map[string]interface{}) (int64, error) {
+	return client.ExportItemsCtx(context.Background(), AppID(appId), exportFormat, params)
+}
+
+// ExportItemsCtx is the context-aware version of ExportItems.
+func (client *Client) ExportItemsCtx(ctx context.Context, appId AppID, exportFormat string, params map[string]interface{}) (int64, error) {
 	path := fmt.Sprintf("/item/app/%d/export/%s", appId, exportFormat)
 	rsp := &struct {
 		BatchId int64 `json:"batch_id"`
 	}{}
 
-	err := client.RequestWithParams("POST", path, nil, params, rsp)
+	err := client.RequestWithParamsCtx(ctx, "POST", path, nil, params, rsp)
 
 	return rsp.BatchId, err
 }
 
 // https://developers.podio.com/doc/items/get-item-by-app-item-id-66506688
 func (client *Client) GetItemByAppItemId(appId int64, formattedAppItemId string) (item *Item, err error) {
+	return client.GetItemByAppItemIdCtx(context.Background(), AppID(appId), formattedAppItemId)
+}
+
+// GetItemByAppItemIdCtx is the context-aware version of GetItemByAppItemId.
+func (client *Client) GetItemByAppItemIdCtx(ctx context.Context, appId AppID, formattedAppItemId string) (item *Item, err error) {
 	path := fmt.Sprintf("/app/%d/item/%s", appId, formattedAppItemId)
-	err = client.Request("GET", path, nil, nil, &item)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &item)
 	return
 }
 
 // https://developers.podio.com/doc/items/get-item-by-app-item-id-66506688
 func (client *Client) GetItemSimpleByAppItemId(appId int64, formattedAppItemId string) (item *ItemSimple, err error) {
+	return client.GetItemSimpleByAppItemIdCtx(context.Background(), AppID(appId), formattedAppItemId)
+}
+
+// GetItemSimpleByAppItemIdCtx is the context-aware version of GetItemSimpleByAppItemId.
+func (client *Client) GetItemSimpleByAppItemIdCtx(ctx context.Context, appId AppID, formattedAppItemId string) (item *ItemSimple, err error) {
 	path := fmt.Sprintf("/app/%d/item/%s", appId, formattedAppItemId)
-	err = client.Request("GET", path, nil, nil, &item)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &item)
 	return
 }
 
 // https://developers.podio.com/doc/items/get-item-by-external-id-19556702
 func (client *Client) GetItemByExternalID(appId int64, externalId string) (item *Item, err error) {
+	return client.GetItemByExternalIDCtx(context.Background(), AppID(appId), externalId)
+}
+
+// GetItemByExternalIDCtx is the context-aware version of GetItemByExternalID.
+func (client *Client) GetItemByExternalIDCtx(ctx context.Context, appId AppID, externalId string) (item *Item, err error) {
 	path := fmt.Sprintf("/item/app/%d/external_id/%s", appId, externalId)
-	err = client.Request("GET", path, nil, nil, &item)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &item)
 	return
 }
 
 // https://developers.podio.com/doc/items/get-item-22360
 func (client *Client) GetItem(itemId int64) (item *Item, err error) {
-	path := fmt.Sprintf("/item/%d?fields=files", itemId)
-	err = client.Request("GET", path, nil, nil, &item)
+	return client.GetItemCtx(context.Background(), ItemID(itemId))
+}
+
+// GetItemCtx is the context-aware version of GetItem.
+func (client *Client) GetItemCtx(ctx context.Context, itemId ItemID) (item *Item, err error) {
+	path := fmt.Sprintf("/item/%d?fields=%s", itemId, Select("files"))
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &item)
 	return
 }
 
 // get item (and more specifically app fields) in the format Elsa Understands
 // https://developers.podio.com/doc/items/get-item-22360
 func (client *Client) GetItemSimple(itemId int64) (item *ItemSimple, err error) {
+	return client.GetItemSimpleCtx(context.Background(), ItemID(itemId))
+}
+
+// GetItemSimpleCtx is the context-aware version of GetItemSimple.
+func (client *Client) GetItemSimpleCtx(ctx context.Context, itemId ItemID) (item *ItemSimple, err error) {
 	path := fmt.Sprintf("/item/%d", itemId)
-	err = client.Request("GET", path, nil, nil, &item)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &item)
 	return
 }
 
 // https://developers.podio.com/doc/items/get-item-by-external-id-19556702
 func (client *Client) GetItemSimpleByExternalID(appId int64, externalId string) (item *ItemSimple, err error) {
+	return client.GetItemSimpleByExternalIDCtx(context.Background(), AppID(appId), externalId)
+}
+
+// GetItemSimpleByExternalIDCtx is the context-aware version of GetItemSimpleByExternalID.
+func (client *Client) GetItemSimpleByExternalIDCtx(ctx context.Context, appId AppID, externalId string) (item *ItemSimple, err error) {
 	path := fmt.Sprintf("/item/app/%d/external_id/%s", appId, externalId)
-	err = client.Request("GET", path, nil, nil, &item)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &item)
 	return
 }
 
 // get item with only micro attributes (FYI: there is no way to get a trimmed version from the API, but at least we don't parse all the values)
 // https://developers.podio.com/doc/items/get-item-22360
 func (client *Client) GetItemMicro(itemId int64) (item *ItemMicro, err error) {
+	return client.GetItemMicroCtx(context.Background(), ItemID(itemId))
+}
+
+// GetItemMicroCtx is the context-aware version of GetItemMicro.
+func (client *Client) GetItemMicroCtx(ctx context.Context, itemId ItemID) (item *ItemMicro, err error) {
 	path := fmt.Sprintf("/item/%d", itemId)
-	err = client.Request("GET", path, nil, nil, &item)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &item)
 	return
 }
 
@@ -582,6 +800,12 @@ func (client *Client) GetItemMicro(itemId int64) (item *ItemMicro, err error) {
 
 // https://developers.podio.com/doc/items/add-new-item-22362
 func (client *Client) CreateItem(appId int, externalId string, fieldValues map[string]interface{}) (int64, error) {
+	id, err := client.CreateItemCtx(context.Background(), AppID(appId), externalId, fieldValues)
+	return int64(id), err
+}
+
+// CreateItemCtx is the context-aware version of CreateItem.
+func (client *Client) CreateItemCtx(ctx context.Context, appId AppID, externalId string, fieldValues map[string]interface{}) (ItemID, error) {
 	path := fmt.Sprintf("/item/app/%d", appId)
 	params := map[string]interface{}{
 		"fields": fieldValues,
@@ -592,115 +816,192 @@ func (client *Client) CreateItem(appId int, externalId string, fieldValues map[s
 	}
 
 	rsp := &struct {
-		ItemId int64 `json:"item_id"`
+		ItemId ItemID `json:"item_id"`
 	}{}
-	err := client.RequestWithParams("POST", path, nil, params, rsp)
+	err := client.RequestWithParamsCtx(ctx, "POST", path, nil, params, rsp)
 
 	return rsp.ItemId, err
 }
 
 // https://developers.podio.com/doc/items/add-new-item-22362
 func (client *Client) CreateItemThroughParams(appId int64, params map[string]interface{}, options map[string]interface{}) (item *ItemSimple, err error) {
+	return client.CreateItemThroughParamsCtx(context.Background(), AppID(appId), params, options)
+}
+
+// CreateItemThroughParamsCtx is the context-aware version of CreateItemThroughParams.
+func (client *Client) CreateItemThroughParamsCtx(ctx context.Context, appId AppID, params, options map[string]interface{}) (item *ItemSimple, err error) {
 	path := fmt.Sprintf("/item/app/%d", appId)
 	path, err = client.AddOptionsToPath(path, options)
-	err = client.RequestWithParams("POST", path, nil, params, &item)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &item)
 	return
 }
 
 // https://developers.podio.com/doc/items/add-new-item-22362
+//
+// Deprecated: use CreateItemThroughParamsCtx with CaptureResponseWithBody to also get the raw JSON.
 func (client *Client) CreateItemJson(appId int, params map[string]interface{}, options map[string]interface{}) (rawResponse *json.RawMessage, err error) {
+	return client.CreateItemJsonCtx(context.Background(), AppID(appId), params, options)
+}
+
+// CreateItemJsonCtx is the context-aware version of CreateItemJson.
+//
+// Deprecated: use CreateItemThroughParamsCtx with CaptureResponseWithBody to also get the raw JSON.
+func (client *Client) CreateItemJsonCtx(ctx context.Context, appId AppID, params, options map[string]interface{}) (rawResponse *json.RawMessage, err error) {
 	path := fmt.Sprintf("/item/app/%d", appId)
 	path, err = client.AddOptionsToPath(path, options)
-	err = client.RequestWithParams("POST", path, nil, params, &rawResponse)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &rawResponse)
 	return
 }
 
 // https://developers.podio.com/doc/items/update-item-22363
 func (client *Client) UpdateItem(itemId int, fieldValues map[string]interface{}) error {
+	return client.UpdateItemCtx(context.Background(), ItemID(itemId), fieldValues)
+}
+
+// UpdateItemCtx is the context-aware version of UpdateItem.
+func (client *Client) UpdateItemCtx(ctx context.Context, itemId ItemID, fieldValues map[string]interface{}) error {
 	path := fmt.Sprintf("/item/%d", itemId)
 	params := map[string]interface{}{
 		"fields": fieldValues,
 	}
 
-	return client.RequestWithParams("PUT", path, nil, params, nil)
+	return client.RequestWithParamsCtx(ctx, "PUT", path, nil, params, nil)
 }
 
 // https://developers.podio.com/doc/items/update-item-22363
 func (client *Client) UpdateItemWithParams(itemId int64, params map[string]interface{}, options map[string]interface{}) (err error) {
+	return client.UpdateItemWithParamsCtx(context.Background(), ItemID(itemId), params, options)
+}
+
+// UpdateItemWithParamsCtx is the context-aware version of UpdateItemWithParams.
+func (client *Client) UpdateItemWithParamsCtx(ctx context.Context, itemId ItemID, params, options map[string]interface{}) (err error) {
 	path := fmt.Sprintf("/item/%d", itemId)
 	path, err = client.AddOptionsToPath(path, options)
-	err = client.RequestWithParams("PUT", path, nil, params, nil)
+	err = client.RequestWithParamsCtx(ctx, "PUT", path, nil, params, nil)
 	return
 }
 
 // https://developers.podio.com/doc/items/update-item-22363
+//
+// Deprecated: use UpdateItemWithParamsCtx with CaptureResponse.
 func (client *Client) UpdateItemWithParamsAndStatusCode(itemId int64, params map[string]interface{}, options map[string]interface{}) (statusCode int, err error) {
-	path := fmt.Sprintf("/item/%d", itemId)
-	path, err = client.AddOptionsToPath(path, options)
-	statusCode, err = client.requestWithParamsAndStatusCode("PUT", path, nil, params, nil)
-	return
+	return client.UpdateItemWithParamsAndStatusCodeCtx(context.Background(), ItemID(itemId), params, options)
+}
+
+// UpdateItemWithParamsAndStatusCodeCtx is the context-aware version of UpdateItemWithParamsAndStatusCode.
+//
+// Deprecated: use UpdateItemWithParamsCtx with CaptureResponse.
+func (client *Client) UpdateItemWithParamsAndStatusCodeCtx(ctx context.Context, itemId ItemID, params, options map[string]interface{}) (statusCode int, err error) {
+	var resp Response
+	err = client.UpdateItemWithParamsCtx(CaptureResponse(ctx, &resp), itemId, params, options)
+	return resp.StatusCode, err
 }
 
 // https://developers.podio.com/doc/items/update-item-22363
+//
+// Deprecated: use UpdateItemWithParamsCtx with CaptureResponse.
 func (client *Client) UpdateItemWithParamsAndRemainingRateLimit(itemId int64, params map[string]interface{}, options map[string]interface{}) (rateLimitRemaining, rateLimit int, err error) {
-	path := fmt.Sprintf("/item/%d", itemId)
-	path, err = client.AddOptionsToPath(path, options)
-	rateLimitRemaining, rateLimit, err = client.requestWithParamsAndRemainingLimit("PUT", path, nil, params, nil)
-	return
+	return client.UpdateItemWithParamsAndRemainingRateLimitCtx(context.Background(), ItemID(itemId), params, options)
+}
+
+// UpdateItemWithParamsAndRemainingRateLimitCtx is the context-aware version of UpdateItemWithParamsAndRemainingRateLimit.
+//
+// Deprecated: use UpdateItemWithParamsCtx with CaptureResponse.
+func (client *Client) UpdateItemWithParamsAndRemainingRateLimitCtx(ctx context.Context, itemId ItemID, params, options map[string]interface{}) (rateLimitRemaining, rateLimit int, err error) {
+	var resp Response
+	err = client.UpdateItemWithParamsCtx(CaptureResponse(ctx, &resp), itemId, params, options)
+	return resp.RateLimitRemaining, resp.RateLimit, err
 }
 
 // https://developers.podio.com/doc/items/get-item-count-34819997
 func (client *Client) ItemCount(appId int64, options map[string]interface{}) (count ItemCount, err error) {
+	return client.ItemCountCtx(context.Background(), AppID(appId), options)
+}
+
+// ItemCountCtx is the context-aware version of ItemCount.
+func (client *Client) ItemCountCtx(ctx context.Context, appId AppID, options map[string]interface{}) (count ItemCount, err error) {
 	path := fmt.Sprintf("/item/app/%d/count", appId)
 	path, err = client.AddOptionsToPath(path, options)
 
-	err = client.Request("GET", path, nil, nil, &count)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &count)
 	return
 }
 
 // https://developers.podio.com/doc/items/find-referenceable-items-22485
 func (client *Client) ItemSearchField(AppFieldId int64, options map[string]interface{}) (items []Item, err error) {
+	return client.ItemSearchFieldCtx(context.Background(), FieldID(AppFieldId), options)
+}
+
+// ItemSearchFieldCtx is the context-aware version of ItemSearchField.
+func (client *Client) ItemSearchFieldCtx(ctx context.Context, AppFieldId FieldID, options map[string]interface{}) (items []Item, err error) {
 	path := fmt.Sprintf("/item/field/%d/find", AppFieldId)
 	path, err = client.AddOptionsToPath(path, options)
 
-	err = client.Request("GET", path, nil, nil, &items)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &items)
 	return
 }
 
 // https://developers.podio.com/doc/items/clone-item-37722742
 func (client *Client) ItemClone(itemID int64, options map[string]interface{}) (clonedItemID itemId, err error) {
+	id, err := client.ItemCloneCtx(context.Background(), ItemID(itemID), options)
+	return itemId{Id: id}, err
+}
+
+// ItemCloneCtx is the context-aware version of ItemClone.
+func (client *Client) ItemCloneCtx(ctx context.Context, itemID ItemID, options map[string]interface{}) (clonedItemID ItemID, err error) {
 	path := fmt.Sprintf("/item/%d/clone", itemID)
-	err = client.RequestWithParams("POST", path, nil, options, &clonedItemID)
-	return
+	rsp := &itemId{}
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, options, rsp)
+	return rsp.Id, err
 }
 
 // https://developers.podio.com/doc/items/bulk-delete-items-19406111
 // todo later parse the response (deleted / pending item ids)
 func (client *Client) ItemBulkDelete(appID int64, params map[string]interface{}) (err error) {
+	return client.ItemBulkDeleteCtx(context.Background(), AppID(appID), params)
+}
+
+// ItemBulkDeleteCtx is the context-aware version of ItemBulkDelete.
+func (client *Client) ItemBulkDeleteCtx(ctx context.Context, appID AppID, params map[string]interface{}) (err error) {
 	path := fmt.Sprintf("/item/app/%d/delete", appID)
-	err = client.RequestWithParams("POST", path, nil, params, nil)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, nil)
 	return
 }
 
 // https://developers.podio.com/doc/items/delete-item-22364
 func (client *Client) ItemDelete(itemID int64, params map[string]interface{}) (err error) {
+	return client.ItemDeleteCtx(context.Background(), ItemID(itemID), params)
+}
+
+// ItemDeleteCtx is the context-aware version of ItemDelete.
+func (client *Client) ItemDeleteCtx(ctx context.Context, itemID ItemID, params map[string]interface{}) (err error) {
 	path := fmt.Sprintf("/item/%d", itemID)
-	err = client.RequestWithParams("DELETE", path, nil, params, nil)
+	err = client.RequestWithParamsCtx(ctx, "DELETE", path, nil, params, nil)
 	return
 }
 
 // https://developers.podio.com/doc/items/get-item-references-22439
 func (client *Client) GetItemReferences(itemID int64) (references []*ItemReferences, err error) {
+	return client.GetItemReferencesCtx(context.Background(), ItemID(itemID))
+}
+
+// GetItemReferencesCtx is the context-aware version of GetItemReferences.
+func (client *Client) GetItemReferencesCtx(ctx context.Context, itemID ItemID) (references []*ItemReferences, err error) {
 	path := fmt.Sprintf("/item/%d/reference", itemID)
 
-	err = client.Request("GET", path, nil, nil, &references)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &references)
 	return
 }
 
 // https://developers.podio.com/doc/items/get-references-to-item-by-field-7403920
 func (client *Client) GetItemReferencesByField(itemID, appFieldID int64) (references []*ItemMicro, err error) {
+	return client.GetItemReferencesByFieldCtx(context.Background(), ItemID(itemID), FieldID(appFieldID))
+}
+
+// GetItemReferencesByFieldCtx is the context-aware version of GetItemReferencesByField.
+func (client *Client) GetItemReferencesByFieldCtx(ctx context.Context, itemID ItemID, appFieldID FieldID) (references []*ItemMicro, err error) {
 	path := fmt.Sprintf("/item/%d/reference/field/%d", itemID, appFieldID)
 
-	err = client.Request("GET", path, nil, nil, &references)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &references)
 	return
 }
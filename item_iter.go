@@ -0,0 +1,148 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"math"
+	"strconv"
+)
+
+// maxFilterLimit is the largest page Podio returns when filtering items
+const maxFilterLimit = 500
+
+// IterateItems pages through all items matching params, see
+// https://developers.podio.com/doc/items/filter-items-4496747
+//
+// The limit in params is replaced by the page size, an offset in params (a number or
+// a numeric string) works like FromOffset. Paging stops once Filtered items are read. Every page is a regular
+// request, so it waits for the rate limiter and stops with the error of a cancelled context.
+//
+//	for item, err := range client.IterateItems(appId, params) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (client *Client) IterateItems(appId int64, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*Item, error] {
+	return client.IterateItemsCtx(context.Background(), AppID(appId), params, opts...)
+}
+
+// IterateItemsCtx is the context-aware version of IterateItems.
+func (client *Client) IterateItemsCtx(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*Item, error] {
+	return iterateItemPages(ctx, params, opts, func(ctx context.Context, params map[string]interface{}) ([]*Item, int, error) {
+		list, err := client.FilterItemsCtx(ctx, appId, params)
+		if err != nil || list == nil {
+			return nil, 0, err
+		}
+		return list.Items, list.Filtered, nil
+	})
+}
+
+// IterateItemsSimple is IterateItems for FilterItemsSimple.
+func (client *Client) IterateItemsSimple(appId int64, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemSimple, error] {
+	return client.IterateItemsSimpleCtx(context.Background(), AppID(appId), params, opts...)
+}
+
+// IterateItemsSimpleCtx is the context-aware version of IterateItemsSimple.
+func (client *Client) IterateItemsSimpleCtx(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemSimple, error] {
+	return iterateItemPages(ctx, params, opts, func(ctx context.Context, params map[string]interface{}) ([]*ItemSimple, int, error) {
+		list, err := client.FilterItemsSimpleCtx(ctx, appId, params)
+		if err != nil || list == nil {
+			return nil, 0, err
+		}
+		return list.Items, list.Filtered, nil
+	})
+}
+
+// IterateItemsMicro is IterateItems for FilterItemsMicro.
+func (client *Client) IterateItemsMicro(appId int64, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemMicro, error] {
+	return client.IterateItemsMicroCtx(context.Background(), AppID(appId), params, opts...)
+}
+
+// IterateItemsMicroCtx is the context-aware version of IterateItemsMicro.
+func (client *Client) IterateItemsMicroCtx(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemMicro, error] {
+	return iterateItemPages(ctx, params, opts, func(ctx context.Context, params map[string]interface{}) ([]*ItemMicro, int, error) {
+		list, err := client.FilterItemsMicroCtx(ctx, appId, params)
+		if err != nil || list == nil {
+			return nil, 0, err
+		}
+		return list.Items, list.Filtered, nil
+	})
+}
+
+// IterateItemsMini is IterateItems for FilterItemsMini.
+func (client *Client) IterateItemsMini(appId int64, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemMini, error] {
+	return client.IterateItemsMiniCtx(context.Background(), AppID(appId), params, opts...)
+}
+
+// IterateItemsMiniCtx is the context-aware version of IterateItemsMini.
+func (client *Client) IterateItemsMiniCtx(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemMini, error] {
+	return iterateItemPages(ctx, params, opts, func(ctx context.Context, params map[string]interface{}) ([]*ItemMini, int, error) {
+		list, err := client.FilterItemsMiniCtx(ctx, appId, params)
+		if err != nil || list == nil {
+			return nil, 0, err
+		}
+		return list.Items, list.Filtered, nil
+	})
+}
+
+// paramOffset reads the offset in params, which may be any integer type, a whole float64
+// (as decoded from JSON) or a string
+func paramOffset(v interface{}) (int, error) {
+	offset := 0
+	switch n := v.(type) {
+	case nil:
+	case int:
+		offset = n
+	case int32:
+		offset = int(n)
+	case int64:
+		offset = int(n)
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("podio: offset must be a whole number, got %v", n)
+		}
+		offset = int(n)
+	case json.Number:
+		i, err := n.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("podio: invalid offset %q", n)
+		}
+		offset = int(i)
+	case string:
+		i, err := strconv.Atoi(n)
+		if err != nil {
+			return 0, fmt.Errorf("podio: invalid offset %q", n)
+		}
+		offset = i
+	default:
+		return 0, fmt.Errorf("podio: offset must be a number, got %T", v)
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("podio: offset must not be negative, got %d", offset)
+	}
+	return offset, nil
+}
+
+// iterateItemPages pages through the filter results, fetch returns the items of a page
+// and the number of items matching the filter
+func iterateItemPages[T any](ctx context.Context, params map[string]interface{}, opts []IterateOption, fetch func(context.Context, map[string]interface{}) ([]T, int, error)) iter.Seq2[T, error] {
+	options := newIterateOptions(maxFilterLimit, opts)
+	offset, err := paramOffset(params["offset"])
+	if err != nil {
+		return func(yield func(T, error) bool) {
+			var zero T
+			yield(zero, err)
+		}
+	}
+	if options.offset == 0 {
+		options.offset = offset
+	}
+
+	return iteratePages(ctx, options, func(ctx context.Context, limit, offset int) ([]T, bool, error) {
+		items, filtered, err := fetch(ctx, pageParams(params, limit, offset))
+		return items, offset+len(items) < filtered, err
+	})
+}
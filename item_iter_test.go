@@ -0,0 +1,123 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newPagingServer serves total items from the filter endpoint and records the offsets requested
+func newPagingServer(total int) (*httptest.Server, *[]int) {
+	var mu sync.Mutex
+	offsets := []int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var params struct {
+			Limit  int `json:"limit"`
+			Offset int `json:"offset"`
+		}
+		json.NewDecoder(req.Body).Decode(&params)
+		mu.Lock()
+		offsets = append(offsets, params.Offset)
+		mu.Unlock()
+
+		items := []map[string]interface{}{}
+		for i := params.Offset; i < total && i < params.Offset+params.Limit; i++ {
+			items = append(items, map[string]interface{}{"item_id": i + 1, "title": fmt.Sprint("item ", i+1)})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"filtered": total, "total": total, "items": items})
+	}))
+	return server, &offsets
+}
+
+func TestIterateItems(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		t.Run(fmt.Sprint("prefetch=", prefetch), func(t *testing.T) {
+			r := require.New(t)
+			server, offsets := newPagingServer(7)
+			defer server.Close()
+			client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+			opts := []IterateOption{PageSize(3)}
+			if prefetch {
+				opts = append(opts, Prefetch())
+			}
+
+			var ids []ItemID
+			for item, err := range client.IterateItemsMicroCtx(context.Background(), 1, map[string]interface{}{"sort_by": "created_on"}, opts...) {
+				r.NoError(err)
+				ids = append(ids, item.Id)
+			}
+			r.Equal([]ItemID{1, 2, 3, 4, 5, 6, 7}, ids)
+			r.Equal([]int{0, 3, 6}, *offsets)
+		})
+	}
+}
+
+func TestIterateItemsStopsEarly(t *testing.T) {
+	r := require.New(t)
+	server, offsets := newPagingServer(10)
+	defer server.Close()
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+	count := 0
+	for _, err := range client.IterateItems(1, map[string]interface{}{"offset": 2}, PageSize(2), Prefetch()) {
+		r.NoError(err)
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	r.Equal(3, count)
+	r.Equal(2, (*offsets)[0])
+}
+
+func TestIterateItemsOffsetTypes(t *testing.T) {
+	r := require.New(t)
+
+	for _, offset := range []interface{}{int64(4), float64(4), "4", json.Number("4")} {
+		server, offsets := newPagingServer(6)
+		client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+		count := 0
+		for _, err := range client.IterateItems(1, map[string]interface{}{"offset": offset}) {
+			r.NoError(err)
+			count++
+		}
+		server.Close()
+		r.Equal(2, count, "offset %#v", offset)
+		r.Equal([]int{4}, *offsets)
+	}
+
+	for _, offset := range []interface{}{4.5, "four", -1, true} {
+		var errs []error
+		for _, err := range NewClient(&AuthToken{AccessToken: "token"}).IterateItems(1, map[string]interface{}{"offset": offset}) {
+			errs = append(errs, err)
+		}
+		r.Len(errs, 1)
+		r.ErrorContains(errs[0], "offset")
+	}
+}
+
+func TestIterateItemsCancelled(t *testing.T) {
+	r := require.New(t)
+	server, _ := newPagingServer(10)
+	defer server.Close()
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var lastErr error
+	for _, err := range client.IterateItemsMiniCtx(ctx, 1, nil, PageSize(5)) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		cancel()
+	}
+	r.ErrorIs(lastErr, context.Canceled)
+}
@@ -1,6 +1,7 @@
 package podio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -16,14 +17,24 @@ type ItemRevision struct {
 
 // https://developers.podio.com/doc/items/revert-to-revision-194362682
 func (client *Client) RevertToRevision(ItemId int64, revisionId int) (rawResponse *json.RawMessage, err error) {
+	return client.RevertToRevisionCtx(context.Background(), ItemID(ItemId), revisionId)
+}
+
+// RevertToRevisionCtx is the context-aware version of RevertToRevision.
+func (client *Client) RevertToRevisionCtx(ctx context.Context, ItemId ItemID, revisionId int) (rawResponse *json.RawMessage, err error) {
 	path := fmt.Sprintf("/item/%d/revision/%d/revert_to", ItemId, revisionId)
-	err = client.Request("POST", path, nil, nil, &rawResponse)
+	err = client.RequestCtx(ctx, "POST", path, nil, nil, &rawResponse)
 	return
 }
 
 // https://developers.podio.com/doc/items/get-item-revisions-22372
 func (client *Client) RevisionsByItemId(ItemId int64) (revisions []ItemRevision, err error) {
+	return client.RevisionsByItemIdCtx(context.Background(), ItemID(ItemId))
+}
+
+// RevisionsByItemIdCtx is the context-aware version of RevisionsByItemId.
+func (client *Client) RevisionsByItemIdCtx(ctx context.Context, ItemId ItemID) (revisions []ItemRevision, err error) {
 	path := fmt.Sprintf("/item/%d/revision/", ItemId)
-	err = client.Request("GET", path, nil, nil, &revisions)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &revisions)
 	return
 }
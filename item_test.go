@@ -2,6 +2,10 @@ package podio
 
 import (
 	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -26,3 +30,126 @@ func TestUnmarshalTextValue(t *testing.T) {
 	r.Len(values, 1)
 	r.Equal(values[0].Value, "a")
 }
+
+func TestUnmarshalMalformedValueReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	fieldJson := []byte(`{
+		"field_id": 1,
+		"external_id": "amount",
+		"type": "number",
+		"values": [{
+			"value": "not a number"
+		}]
+	}`)
+
+	field := &Field{}
+	err := json.Unmarshal(fieldJson, field)
+	r.Error(err)
+	r.Contains(err.Error(), "field 1 (amount)")
+}
+
+func TestItemJSONRoundTrip(t *testing.T) {
+	r := require.New(t)
+
+	data, err := os.ReadFile("testdata/item.json")
+	r.NoError(err)
+
+	item := &Item{}
+	r.NoError(json.Unmarshal(data, item))
+	encoded, err := json.Marshal(item)
+	r.NoError(err)
+	decoded := &Item{}
+	r.NoError(json.Unmarshal(encoded, decoded))
+	for i, field := range decoded.Fields {
+		r.Equal(item.Fields[i], field, field.Type)
+		if field.ExternalId != "empty" {
+			r.Greater(reflect.ValueOf(field.Values).Len(), 0, field.Type)
+		}
+	}
+	r.Equal(item, decoded)
+
+	// the encoded item must say what Podio sent, without zero-valued objects Podio left out
+	var original, reencoded interface{}
+	r.NoError(json.Unmarshal(data, &original))
+	r.NoError(json.Unmarshal(encoded, &reencoded))
+	requireSameJSON(t, original, reencoded, "item")
+
+	simple := &ItemSimple{}
+	r.NoError(json.Unmarshal(data, simple))
+	encoded, err = json.Marshal(simple)
+	r.NoError(err)
+	decodedSimple := &ItemSimple{}
+	r.NoError(json.Unmarshal(encoded, decodedSimple))
+	r.Equal(simple, decodedSimple)
+}
+
+func TestMarshalField(t *testing.T) {
+	r := require.New(t)
+
+	field := &Field{PartialField: PartialField{Id: 7, ExternalId: "budget", Type: "money"}, Values: []MoneyValue{{Value: 100.5, Currency: "EUR"}}}
+	encoded, err := json.Marshal(field)
+	r.NoError(err)
+	r.Equal(`{"field_id":7,"external_id":"budget","type":"money","label":"","values":[{"value":"100.5","currency":"EUR"}]}`, string(encoded))
+}
+
+// requireSameJSON checks that encoded has every value of original, numbers may be formatted
+// differently (Podio sends "12.5000"). Keys only in encoded must have zero values like "" or null,
+// objects Podio left out must not be added.
+func requireSameJSON(t *testing.T, original, encoded interface{}, path string) {
+	t.Helper()
+	switch want := original.(type) {
+	case map[string]interface{}:
+		got, ok := encoded.(map[string]interface{})
+		if !ok {
+			t.Fatalf("%s: got %v, want an object", path, encoded)
+		}
+		for key, value := range want {
+			requireSameJSON(t, value, got[key], path+"."+key)
+		}
+		for key, value := range got {
+			if _, ok := want[key]; !ok && !isZeroJSON(value) {
+				t.Fatalf("%s.%s: got %v, Podio left it out", path, key, value)
+			}
+		}
+	case []interface{}:
+		got, ok := encoded.([]interface{})
+		if !ok || len(got) != len(want) {
+			t.Fatalf("%s: got %v, want %v", path, encoded, original)
+		}
+		for i := range want {
+			requireSameJSON(t, want[i], got[i], fmt.Sprintf("%s[%d]", path, i))
+		}
+	default:
+		// null decodes into the zero value of fields that aren't pointers
+		if original == encoded || (original == nil && isZeroJSON(encoded)) {
+			return
+		}
+		wantString, isString := original.(string)
+		gotString, ok := encoded.(string)
+		if isString && ok {
+			wantNumber, errWant := strconv.ParseFloat(wantString, 64)
+			gotNumber, errGot := strconv.ParseFloat(gotString, 64)
+			if errWant == nil && errGot == nil && wantNumber == gotNumber {
+				return
+			}
+		}
+		t.Fatalf("%s: got %v, want %v", path, encoded, original)
+	}
+}
+
+func isZeroJSON(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,261 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ItemValues builds the field values of CreateItem, UpdateItem and CloneItemWithValues
+// in the formats Podio expects, see https://developers.podio.com/doc/items
+//
+//	fields, err := podio.NewItemValues().
+//		ForApp(app).
+//		Text("title", "Launch").
+//		Categories("status", 2).
+//		Date("deadline", deadline, time.Time{}).
+//		Money("budget", 1200, "EUR").
+//		AppRefs("project", projectId).
+//		Map()
+//	itemId, err := client.CreateItemCtx(ctx, app.Id, "", fields)
+//
+// Fields are given by external id or by field id, see FieldKey.
+type ItemValues struct {
+	app    *App
+	values map[string]interface{}
+	types  map[string]valueType
+	err    error
+}
+
+// valueType says which field types a value is written to
+type valueType string
+
+const (
+	valueTypeText     valueType = "text"
+	valueTypeNumber   valueType = "number"
+	valueTypeMoney    valueType = "money"
+	valueTypeProgress valueType = "progress"
+	valueTypeDuration valueType = "duration"
+	valueTypeDate     valueType = "date"
+	valueTypeCategory valueType = "category"
+	valueTypeApp      valueType = "app"
+	valueTypeContact  valueType = "contact"
+	valueTypeLocation valueType = "location"
+	valueTypePhone    valueType = "phone"
+	valueTypeEmail    valueType = "email"
+	valueTypeImage    valueType = "image"
+	valueTypeEmbed    valueType = "embed"
+	valueTypeRaw      valueType = "raw"
+)
+
+var valueTypeFieldTypes = map[valueType][]string{
+	valueTypeText:     {"text"},
+	valueTypeNumber:   {"number"},
+	valueTypeMoney:    {"money"},
+	valueTypeProgress: {"progress"},
+	valueTypeDuration: {"duration"},
+	valueTypeDate:     {"date"},
+	valueTypeCategory: {"category", "question"},
+	valueTypeApp:      {"app"},
+	valueTypeContact:  {"contact"},
+	valueTypeLocation: {"location"},
+	valueTypePhone:    {"phone"},
+	valueTypeEmail:    {"email"},
+	valueTypeImage:    {"image"},
+	valueTypeEmbed:    {"embed"},
+}
+
+// FieldKey is the key of a field given by its id in ItemValues and ItemFilter.
+func FieldKey(fieldId FieldID) string {
+	return strconv.FormatInt(int64(fieldId), 10)
+}
+
+func NewItemValues() *ItemValues {
+	return &ItemValues{values: map[string]interface{}{}, types: map[string]valueType{}}
+}
+
+// ForApp makes Map check the values against the fields of app
+func (v *ItemValues) ForApp(app *App) *ItemValues {
+	v.app = app
+	return v
+}
+
+func (v *ItemValues) set(field string, typ valueType, value interface{}) *ItemValues {
+	v.values[field] = value
+	v.types[field] = typ
+	return v
+}
+
+func (v *ItemValues) fail(err error) *ItemValues {
+	if v.err == nil {
+		v.err = err
+	}
+	return v
+}
+
+// Text sets a text field
+func (v *ItemValues) Text(field string, value string) *ItemValues {
+	return v.set(field, valueTypeText, value)
+}
+
+// Number sets a number field
+func (v *ItemValues) Number(field string, value float64) *ItemValues {
+	return v.set(field, valueTypeNumber, value)
+}
+
+// Money sets a money field to amount in currency, e.g. "EUR"
+func (v *ItemValues) Money(field string, amount float64, currency string) *ItemValues {
+	return v.set(field, valueTypeMoney, map[string]interface{}{
+		"value":    strconv.FormatFloat(amount, 'f', -1, 64),
+		"currency": currency,
+	})
+}
+
+// Progress sets a progress field to a percentage from 0 to 100
+func (v *ItemValues) Progress(field string, percent int) *ItemValues {
+	if percent < 0 || percent > 100 {
+		return v.fail(fmt.Errorf("podio: progress of field %s must be between 0 and 100, got %d", field, percent))
+	}
+	return v.set(field, valueTypeProgress, percent)
+}
+
+// Duration sets a duration field, Podio stores whole seconds
+func (v *ItemValues) Duration(field string, duration time.Duration) *ItemValues {
+	return v.set(field, valueTypeDuration, int64(duration/time.Second))
+}
+
+// Date sets a date field with a time, a zero end leaves the end empty
+func (v *ItemValues) Date(field string, start, end time.Time) *ItemValues {
+	return v.set(field, valueTypeDate, dateFieldValue(Date{Start: start, End: end}))
+}
+
+// AllDayDate sets a date field without a time, a zero end leaves the end empty
+func (v *ItemValues) AllDayDate(field string, start, end time.Time) *ItemValues {
+	return v.set(field, valueTypeDate, dateFieldValue(Date{Start: start, End: end, AllDay: true}))
+}
+
+// Categories sets the options of a category or question field
+func (v *ItemValues) Categories(field string, optionIds ...int) *ItemValues {
+	return v.set(field, valueTypeCategory, optionIds)
+}
+
+// AppRefs sets the items referenced by an app reference field
+func (v *ItemValues) AppRefs(field string, itemIds ...ItemID) *ItemValues {
+	return v.set(field, valueTypeApp, itemIds)
+}
+
+// Contacts sets the profiles of a contact field
+func (v *ItemValues) Contacts(field string, profileIds ...ProfileID) *ItemValues {
+	return v.set(field, valueTypeContact, profileIds)
+}
+
+// Location sets a location field to an address
+func (v *ItemValues) Location(field string, address string) *ItemValues {
+	return v.set(field, valueTypeLocation, map[string]interface{}{"value": address})
+}
+
+// Phones sets a phone field, the type of a number is e.g. "mobile", "work" or "home"
+func (v *ItemValues) Phones(field string, phones ...PhoneValue) *ItemValues {
+	return v.set(field, valueTypePhone, phones)
+}
+
+// Emails sets an email field, the type of an address is e.g. "work", "home" or "other"
+func (v *ItemValues) Emails(field string, emails ...EmailValue) *ItemValues {
+	return v.set(field, valueTypeEmail, emails)
+}
+
+// Images sets the files of an image field, upload them with CreateFile first
+func (v *ItemValues) Images(field string, fileIds ...FileID) *ItemValues {
+	return v.set(field, valueTypeImage, fileIds)
+}
+
+// Embeds sets the links of an embed field, create them with CreateEmbed first
+func (v *ItemValues) Embeds(field string, embedIds ...int) *ItemValues {
+	embeds := make([]map[string]interface{}, len(embedIds))
+	for i, embedId := range embedIds {
+		embeds[i] = map[string]interface{}{"embed": embedId}
+	}
+	return v.set(field, valueTypeEmbed, embeds)
+}
+
+// Clear empties a field of any type
+func (v *ItemValues) Clear(field string) *ItemValues {
+	return v.set(field, valueTypeRaw, []interface{}{})
+}
+
+// Set sets a value as is, for values without a typed setter
+func (v *ItemValues) Set(field string, value interface{}) *ItemValues {
+	return v.set(field, valueTypeRaw, value)
+}
+
+// Validate checks the values against the fields of app: every field must exist and
+// have the type of its setter
+func (v *ItemValues) Validate(app *App) error {
+	if v.err != nil {
+		return v.err
+	}
+	keys := make([]string, 0, len(v.types))
+	for key := range v.types {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		typ := v.types[key]
+		field := app.field(key)
+		if field == nil {
+			return fmt.Errorf("podio: app %d has no field %q", app.Id, key)
+		}
+		if typ != valueTypeRaw && !contains(valueTypeFieldTypes[typ], field.Type) {
+			return fmt.Errorf("podio: cannot set a %s value on field %q of type %s", typ, key, field.Type)
+		}
+	}
+	return nil
+}
+
+// Map returns the field values for CreateItem and UpdateItem, checked against the app given to ForApp
+func (v *ItemValues) Map() (map[string]interface{}, error) {
+	if v.err != nil {
+		return nil, v.err
+	}
+	if v.app != nil {
+		if err := v.Validate(v.app); err != nil {
+			return nil, err
+		}
+	}
+	values := make(map[string]interface{}, len(v.values))
+	for key, value := range v.values {
+		values[key] = value
+	}
+	return values, nil
+}
+
+// CloneItemWithValues clones an item and sets values on the clone, see
+// https://developers.podio.com/doc/items/clone-item-37722742
+// When the values can't be set the clone is deleted again and the error returned. If deleting
+// it fails as well, the error says so and the id of the clone is returned with it.
+func (client *Client) CloneItemWithValues(itemId int64, values *ItemValues, options map[string]interface{}) (int64, error) {
+	id, err := client.CloneItemWithValuesCtx(context.Background(), ItemID(itemId), values, options)
+	return int64(id), err
+}
+
+// CloneItemWithValuesCtx is the context-aware version of CloneItemWithValues.
+func (client *Client) CloneItemWithValuesCtx(ctx context.Context, itemId ItemID, values *ItemValues, options map[string]interface{}) (clonedItemId ItemID, err error) {
+	fields, err := values.Map()
+	if err != nil {
+		return 0, err
+	}
+	clonedItemId, err = client.ItemCloneCtx(ctx, itemId, options)
+	if err != nil || len(fields) == 0 {
+		return
+	}
+	if err = client.UpdateItemCtx(ctx, clonedItemId, fields); err != nil {
+		// clean up even when ctx is the reason the update failed
+		if deleteErr := client.ItemDeleteCtx(context.WithoutCancel(ctx), clonedItemId, nil); deleteErr != nil {
+			return clonedItemId, fmt.Errorf("%w (deleting the clone %d failed too: %v)", err, clonedItemId, deleteErr)
+		}
+		return 0, err
+	}
+	return clonedItemId, nil
+}
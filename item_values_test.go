@@ -0,0 +1,113 @@
+package podio
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemValuesMap(t *testing.T) {
+	r := require.New(t)
+
+	start := time.Date(2024, 3, 1, 9, 30, 0, 0, time.FixedZone("CET", 3600))
+	fields, err := NewItemValues().
+		Text("title", "Launch").
+		Number("hours", 7.5).
+		Money("budget", 1200.5, "EUR").
+		Progress("done", 40).
+		Duration("estimate", 90*time.Minute).
+		Date("deadline", start, time.Time{}).
+		AllDayDate("period", start, start.AddDate(0, 0, 2)).
+		Categories("status", 2).
+		AppRefs("project", 10, 11).
+		Contacts("owner", 5).
+		Location("office", "Copenhagen").
+		Phones("phone", PhoneValue{Type: "work", Value: "+45 1234"}).
+		Emails("email", EmailValue{Type: "work", Value: "a@example.com"}).
+		Images("logo", 3).
+		Embeds("site", 4).
+		Clear("notes").
+		Set(FieldKey(99), "raw").
+		Map()
+	r.NoError(err)
+
+	buf, err := json.Marshal(fields)
+	r.NoError(err)
+	r.Equal(`{"99":"raw","budget":{"currency":"EUR","value":"1200.5"},"deadline":{"start_utc":"2024-03-01 08:30:00"},"done":40,"email":[{"value":"a@example.com","type":"work"}],"estimate":5400,"hours":7.5,"logo":[3],"notes":[],"office":{"value":"Copenhagen"},"owner":[5],"period":{"end_date":"2024-03-03","start_date":"2024-03-01"},"phone":[{"value":"+45 1234","type":"work"}],"project":[10,11],"site":[{"embed":4}],"status":[2],"title":"Launch"}`, string(buf))
+
+	_, err = NewItemValues().Progress("done", 120).Map()
+	r.ErrorContains(err, "between 0 and 100")
+}
+
+func TestItemValuesValidate(t *testing.T) {
+	r := require.New(t)
+	app := &App{Id: 1, Fields: []AppField{
+		{Id: 10, ExternalId: "status", Type: "category"},
+		{Id: 11, ExternalId: "title", Type: "text"},
+	}}
+
+	r.NoError(NewItemValues().Categories("status", 1).Text(FieldKey(11), "x").Validate(app))
+	r.ErrorContains(NewItemValues().Text("status", "open").Validate(app), `field "status" of type category`)
+	r.ErrorContains(NewItemValues().Text("missing", "x").Validate(app), `no field "missing"`)
+
+	_, err := NewItemValues().ForApp(app).Number("title", 1).Map()
+	r.Error(err)
+}
+
+func TestCloneItemWithValues(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		requests = append(requests, req.Method+" "+req.URL.Path+" "+string(body))
+		if req.URL.Path == "/item/1/clone" {
+			w.Write([]byte(`{"item_id": 2}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+	clonedId, err := client.CloneItemWithValues(1, NewItemValues().Text("title", "Copy"), nil)
+	r.NoError(err)
+	r.Equal(int64(2), clonedId)
+	r.Equal([]string{"POST /item/1/clone null", `PUT /item/2 {"fields":{"title":"Copy"}}`}, requests)
+}
+
+func TestCloneItemWithValuesDeletesCloneOnFailure(t *testing.T) {
+	r := require.New(t)
+
+	var requests []string
+	deleteStatus := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch req.Method {
+		case "POST":
+			w.Write([]byte(`{"item_id": 2}`))
+		case "PUT":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "invalid_value", "error_description": "bad title"}`))
+		case "DELETE":
+			w.WriteHeader(deleteStatus)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+	clonedId, err := client.CloneItemWithValues(1, NewItemValues().Text("title", "Copy"), nil)
+	r.ErrorContains(err, "bad title")
+	r.Equal(int64(0), clonedId)
+	r.Equal([]string{"POST /item/1/clone", "PUT /item/2", "DELETE /item/2"}, requests)
+
+	deleteStatus = http.StatusForbidden
+	clonedId, err = client.CloneItemWithValues(1, NewItemValues().Text("title", "Copy"), nil)
+	r.ErrorContains(err, "deleting the clone 2 failed too")
+	r.Equal(int64(2), clonedId)
+}
@@ -0,0 +1,35 @@
+package podio
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+var packageLogger atomic.Pointer[slog.Logger]
+
+// SetLogger sets the logger used where no client is involved (e.g. downloading files)
+// and by clients without WithLogger. By default slog.Default() is used, nil restores that.
+func SetLogger(logger *slog.Logger) {
+	packageLogger.Store(logger)
+}
+
+func defaultLogger() *slog.Logger {
+	if logger := packageLogger.Load(); logger != nil {
+		return logger
+	}
+	return slog.Default()
+}
+
+// WithLogger sets the logger for retries, rate limiting and token refreshes of the client
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(client *Client) {
+		client.logger = logger
+	}
+}
+
+func (client *Client) log() *slog.Logger {
+	if client.logger != nil {
+		return client.logger
+	}
+	return defaultLogger()
+}
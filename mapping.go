@@ -0,0 +1,742 @@
+package podio
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FieldGetter is an item with fields, i.e. *Item or *ItemSimple
+type FieldGetter interface {
+	Field(key string) FieldValues
+}
+
+// fieldTag is a parsed `podio:"external_id=status,type=category"` struct tag
+type fieldTag struct {
+	key      string
+	typ      string
+	currency string
+	keepZero bool
+}
+
+func parseFieldTag(structField reflect.StructField) (tag fieldTag, ok bool, err error) {
+	raw, found := structField.Tag.Lookup("podio")
+	if !found || raw == "-" {
+		return tag, false, nil
+	}
+
+	var externalId string
+	var fieldId int64
+	for _, part := range strings.Split(raw, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch name {
+		case "external_id":
+			externalId = value
+		case "field_id":
+			fieldId, err = strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return tag, false, fmt.Errorf("invalid field_id %q", value)
+			}
+		case "type":
+			tag.typ = value
+		case "currency":
+			tag.currency = value
+		case "keepzero":
+			tag.keepZero = true
+		default:
+			return tag, false, fmt.Errorf("unknown key %q in podio tag", name)
+		}
+	}
+
+	switch {
+	case externalId != "":
+		tag.key = externalId
+	case fieldId != 0:
+		tag.key = FieldKey(FieldID(fieldId))
+	default:
+		return tag, false, errors.New("podio tag needs an external_id or a field_id")
+	}
+	return tag, true, nil
+}
+
+// UnmarshalItem sets the fields of the struct v points to from the fields of item.
+// Struct fields are mapped with tags like
+//
+//	type Project struct {
+//		Title    string           `podio:"external_id=title,type=text"`
+//		Status   []CategoryOption `podio:"external_id=status,type=category"`
+//		Budget   *MoneyValue      `podio:"external_id=budget,type=money"`
+//		Deadline *Date            `podio:"field_id=1234,type=date"`
+//		Owners   []ProfileID      `podio:"external_id=owners,type=contact"`
+//	}
+//
+// A struct field can have the value type of the field (e.g. MoneyValue) or a type of one
+// of its parts (e.g. the float64 amount, the int id or string text of a category option,
+// the ItemID of a referenced item or the Date of a date). Numbers are only converted when
+// they fit the struct field exactly. A time.Time takes the start of a timed date, all-day
+// dates need a Date to keep them all-day. Pointers are nil and
+// slices empty when the item doesn't have a value, slices take all values of a field.
+// The type in the tag is optional, when given it must match the type of the field.
+func UnmarshalItem(item FieldGetter, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("podio: UnmarshalItem needs a pointer to a struct, got %T", v)
+	}
+	rv = rv.Elem()
+
+	for i := 0; i < rv.NumField(); i++ {
+		structField := rv.Type().Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		tag, ok, err := parseFieldTag(structField)
+		if err != nil {
+			return fmt.Errorf("podio: %s.%s: %w", rv.Type().Name(), structField.Name, err)
+		}
+		if !ok {
+			continue
+		}
+
+		target := rv.Field(i)
+		target.Set(reflect.Zero(target.Type()))
+		field := item.Field(tag.key).Raw()
+		if field == nil {
+			continue
+		}
+		if tag.typ != "" && tag.typ != field.Type && tag.typ != field.Config.Settings.ReturnType {
+			return &FieldTypeError{FieldId: field.Id, ExternalId: field.ExternalId, Type: field.Type, Want: tag.typ}
+		}
+		if err := setFieldValues(target, field); err != nil {
+			return fmt.Errorf("podio: %s.%s: %w", rv.Type().Name(), structField.Name, err)
+		}
+	}
+	return nil
+}
+
+func setFieldValues(target reflect.Value, field *Field) error {
+	values := reflect.ValueOf(field.Values)
+	if !values.IsValid() || values.Kind() != reflect.Slice {
+		return nil
+	}
+
+	switch {
+	case target.Kind() == reflect.Slice:
+		if values.Type().AssignableTo(target.Type()) {
+			target.Set(values)
+			return nil
+		}
+		out := reflect.MakeSlice(target.Type(), 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			elem := reflect.New(target.Type().Elem()).Elem()
+			if err := convertValue(values.Index(i), elem); err != nil {
+				return err
+			}
+			out = reflect.Append(out, elem)
+		}
+		target.Set(out)
+
+	case values.Len() == 0:
+		return nil
+
+	case target.Kind() == reflect.Ptr:
+		elem := reflect.New(target.Type().Elem())
+		if err := convertValue(values.Index(0), elem.Elem()); err != nil {
+			return err
+		}
+		target.Set(elem)
+
+	default:
+		return convertValue(values.Index(0), target)
+	}
+	return nil
+}
+
+// convertValue sets target to value or to the first part of value that fits
+func convertValue(value, target reflect.Value) error {
+	if value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	if value.Type().AssignableTo(target.Type()) {
+		target.Set(value)
+		return nil
+	}
+	if target.Kind() == reflect.Ptr {
+		elem := reflect.New(target.Type().Elem())
+		if err := convertValue(value, elem.Elem()); err != nil {
+			return err
+		}
+		target.Set(elem)
+		return nil
+	}
+	for _, part := range valueParts(value.Interface()) {
+		partValue := reflect.ValueOf(part)
+		if partValue.Type().AssignableTo(target.Type()) {
+			target.Set(partValue)
+			return nil
+		}
+		// only plain numbers and strings are converted, e.g. an int option id to an int64,
+		// but not one kind of id into another
+		if partValue.Type().PkgPath() == "" && sameKind(partValue.Kind(), target.Kind()) {
+			return convertExact(partValue, target)
+		}
+	}
+	if date, ok := value.Interface().(DateValue); ok && date.AllDay() {
+		return fmt.Errorf("cannot map an all-day date to %s, use podio.Date to keep it all-day", target.Type())
+	}
+	return fmt.Errorf("cannot map %s to %s", value.Type(), target.Type())
+}
+
+// convertExact sets target to value converted to its type, unless the conversion loses
+// the fraction of a number or the number doesn't fit
+func convertExact(value, target reflect.Value) error {
+	converted := value.Convert(target.Type())
+	if value.Kind() != reflect.String {
+		back := converted.Convert(value.Type())
+		if back.Interface() != value.Interface() || isNegative(value) != isNegative(converted) {
+			return fmt.Errorf("cannot map %v to %s without losing its value", value.Interface(), target.Type())
+		}
+	}
+	target.Set(converted)
+	return nil
+}
+
+func isNegative(v reflect.Value) bool {
+	switch {
+	case v.CanInt():
+		return v.Int() < 0
+	case v.CanFloat():
+		return v.Float() < 0
+	}
+	return false
+}
+
+func sameKind(a, b reflect.Kind) bool {
+	isNumber := func(k reflect.Kind) bool {
+		return reflect.Int <= k && k <= reflect.Float64
+	}
+	return (isNumber(a) && isNumber(b)) || (a == reflect.String && b == reflect.String)
+}
+
+// valueParts lists the parts of a field value a struct field can have instead of the value itself
+func valueParts(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case TextValue:
+		return []interface{}{v.Value}
+	case TagValue:
+		return []interface{}{v.Value}
+	case NumberValue:
+		return []interface{}{v.Value}
+	case MoneyValue:
+		return []interface{}{v.Value}
+	case ProgressValue:
+		return []interface{}{v.Value}
+	case DurationValue:
+		return []interface{}{time.Duration(v.Value) * time.Second, v.Value}
+	case DateValue:
+		date, _ := v.In(time.UTC)
+		if v.AllDay() {
+			// a time.Time can't tell an all-day date from midnight
+			return []interface{}{date}
+		}
+		start := dateValueStart(v)
+		return []interface{}{start, Time{start}, date}
+	case CategoryValue:
+		return []interface{}{v.Value, v.Value.Id, v.Value.Text}
+	case QuestionValue:
+		return []interface{}{v.Value}
+	case AppValue:
+		return []interface{}{v.Value, v.Value.Id, int64(v.Value.Id), v.Value.Title}
+	case ContactValue:
+		return []interface{}{v.Value, v.Value.ProfileId, v.Value.UserId, int64(v.Value.ProfileId), v.Value.Name}
+	case MemberValue:
+		return []interface{}{v.Value}
+	case ImageValue:
+		return []interface{}{v.Value, v.Value.Id, int64(v.Value.Id)}
+	case LocationValue:
+		return []interface{}{v.Value}
+	case VideoValue:
+		return []interface{}{v.Value}
+	case EmbedValue:
+		return []interface{}{v.Embed, v.Embed.Id}
+	case TelValue:
+		return []interface{}{v.URI}
+	case PhoneValue:
+		return []interface{}{v.Value}
+	case EmailValue:
+		return []interface{}{v.Value}
+	}
+	return nil
+}
+
+// dateValueStart prefers the UTC start, Podio sends it as a date for dates without a time
+func dateValueStart(v DateValue) time.Time {
+	if v.StartUTC != nil {
+		for _, layout := range []string{podioLayout, "2006-01-02"} {
+			if t, err := time.ParseInLocation(layout, *v.StartUTC, time.UTC); err == nil {
+				return t
+			}
+		}
+	}
+	if v.Start != nil {
+		return v.Start.Time
+	}
+	return time.Time{}
+}
+
+// dateFieldValue is the value of a date field for date, see ItemValues.Date and ItemValues.AllDayDate
+func dateFieldValue(date Date) map[string]interface{} {
+	if date.AllDay {
+		value := map[string]interface{}{"start_date": date.Start.Format(podioDateLayout)}
+		if date.HasEnd() {
+			value["end_date"] = date.End.Format(podioDateLayout)
+		}
+		return value
+	}
+	value := map[string]interface{}{"start_utc": date.Start.UTC().Format(podioLayout)}
+	if date.HasEnd() {
+		value["end_utc"] = date.End.UTC().Format(podioLayout)
+	}
+	return value
+}
+
+// MarshalItemValues returns the field values of CreateItem and UpdateItem for the struct v,
+// mapped with the tags of UnmarshalItem. The type in the tag is required. Nil pointers and
+// nil slices are left out, empty slices clear the field and calculations are skipped.
+// Zero values of fields that are not pointers, like "", 0 or a zero time.Time, are left out
+// too unless the tag has the keepzero option, e.g. `podio:"external_id=done,type=progress,keepzero"`.
+// Nil elements of slices are skipped.
+// Money given as a number needs a currency in the tag, e.g. `podio:"external_id=budget,type=money,currency=EUR"`.
+func MarshalItemValues(v interface{}) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("podio: MarshalItemValues needs a struct, got %T", v)
+	}
+
+	values := NewItemValues()
+	for i := 0; i < rv.NumField(); i++ {
+		structField := rv.Type().Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		tag, ok, err := parseFieldTag(structField)
+		if err != nil {
+			return nil, fmt.Errorf("podio: %s.%s: %w", rv.Type().Name(), structField.Name, err)
+		}
+		if !ok {
+			continue
+		}
+		if tag.typ == "" {
+			return nil, fmt.Errorf("podio: %s.%s: podio tag needs a type to marshal", rv.Type().Name(), structField.Name)
+		}
+
+		value := rv.Field(i)
+		switch value.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Interface:
+			if value.IsNil() {
+				continue
+			}
+		default:
+			if value.IsZero() && !tag.keepZero {
+				// an unset field, not a value to write
+				continue
+			}
+		}
+		for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+			value = value.Elem()
+		}
+		if err := marshalFieldValue(values, tag, value); err != nil {
+			return nil, fmt.Errorf("podio: %s.%s: %w", rv.Type().Name(), structField.Name, err)
+		}
+	}
+	return values.Map()
+}
+
+func marshalFieldValue(values *ItemValues, tag fieldTag, value reflect.Value) error {
+	key := tag.key
+	if value.Kind() == reflect.Slice && value.Len() == 0 {
+		values.Clear(key)
+		return nil
+	}
+
+	switch tag.typ {
+	case "calculation":
+		return nil
+
+	case "text", "location":
+		s, err := single(value, func(v interface{}) (string, bool) {
+			switch v := v.(type) {
+			case TextValue:
+				return v.Value, true
+			case LocationValue:
+				return v.Value, true
+			}
+			return stringOf(v)
+		})
+		if err != nil {
+			return err
+		}
+		if tag.typ == "location" {
+			values.Location(key, s)
+		} else {
+			values.Text(key, s)
+		}
+
+	case "number":
+		f, err := single(value, func(v interface{}) (float64, bool) {
+			if n, ok := v.(NumberValue); ok {
+				return n.Value, true
+			}
+			return floatOf(v)
+		})
+		if err != nil {
+			return err
+		}
+		values.Number(key, f)
+
+	case "money":
+		var currency string
+		amount, err := single(value, func(v interface{}) (float64, bool) {
+			switch v := v.(type) {
+			case MoneyValue:
+				currency = v.Currency
+				return v.Value, true
+			case MoneyValueFloat:
+				currency = v.Currency
+				return v.Value, true
+			}
+			currency = tag.currency
+			return floatOf(v)
+		})
+		if err != nil {
+			return err
+		}
+		if currency == "" {
+			return errors.New("money needs a currency, set it in the podio tag")
+		}
+		values.Money(key, amount, currency)
+
+	case "progress":
+		n, err := single(value, func(v interface{}) (float64, bool) {
+			if p, ok := v.(ProgressValue); ok {
+				return float64(p.Value), true
+			}
+			return floatOf(v)
+		})
+		if err != nil {
+			return err
+		}
+		if n != math.Trunc(n) || n < 0 || n > 100 {
+			return fmt.Errorf("progress must be a whole number from 0 to 100, got %v", n)
+		}
+		values.Progress(key, int(n))
+
+	case "duration":
+		d, err := single(value, func(v interface{}) (time.Duration, bool) {
+			switch v := v.(type) {
+			case time.Duration:
+				return v, true
+			case DurationValue:
+				return time.Duration(v.Value) * time.Second, true
+			}
+			seconds, ok := floatOf(v)
+			return time.Duration(seconds) * time.Second, ok
+		})
+		if err != nil {
+			return err
+		}
+		values.Duration(key, d)
+
+	case "date":
+		var dateErr error
+		date, err := single(value, func(v interface{}) (interface{}, bool) {
+			date, err := dateOf(v)
+			if err != errUnsupportedValue {
+				dateErr = err
+			}
+			return date, err == nil
+		})
+		if dateErr != nil {
+			return dateErr
+		}
+		if err != nil {
+			return err
+		}
+		values.set(key, valueTypeDate, date)
+
+	case "category", "question":
+		ids, err := each(value, func(v interface{}) (int64, bool) {
+			switch v := v.(type) {
+			case CategoryOption:
+				return int64(v.Id), true
+			case CategoryValue:
+				return int64(v.Value.Id), true
+			case QuestionValue:
+				return int64(v.Value), true
+			}
+			return intOf(v)
+		})
+		if err != nil {
+			return err
+		}
+		optionIds := make([]int, len(ids))
+		for i, id := range ids {
+			optionIds[i] = int(id)
+		}
+		values.Categories(key, optionIds...)
+
+	case "app":
+		ids, err := each(value, func(v interface{}) (int64, bool) {
+			switch v := v.(type) {
+			case Item:
+				return int64(v.Id), true
+			case ItemSimple:
+				return int64(v.Id), true
+			case ItemMicro:
+				return int64(v.Id), true
+			case AppValue:
+				return int64(v.Value.Id), true
+			case AppValueSimple:
+				return int64(v.ItemId), true
+			}
+			return intOf(v)
+		})
+		if err != nil {
+			return err
+		}
+		itemIds := make([]ItemID, len(ids))
+		for i, id := range ids {
+			itemIds[i] = ItemID(id)
+		}
+		values.AppRefs(key, itemIds...)
+
+	case "contact":
+		ids, err := each(value, func(v interface{}) (int64, bool) {
+			switch v := v.(type) {
+			case Contact:
+				return int64(v.ProfileId), true
+			case ContactValue:
+				return int64(v.Value.ProfileId), true
+			}
+			return intOf(v)
+		})
+		if err != nil {
+			return err
+		}
+		profileIds := make([]ProfileID, len(ids))
+		for i, id := range ids {
+			profileIds[i] = ProfileID(id)
+		}
+		values.Contacts(key, profileIds...)
+
+	case "image":
+		ids, err := each(value, func(v interface{}) (int64, bool) {
+			switch v := v.(type) {
+			case File:
+				return int64(v.Id), true
+			case ImageValue:
+				return int64(v.Value.Id), true
+			case ImageValueSimple:
+				return int64(v.FileId), true
+			}
+			return intOf(v)
+		})
+		if err != nil {
+			return err
+		}
+		fileIds := make([]FileID, len(ids))
+		for i, id := range ids {
+			fileIds[i] = FileID(id)
+		}
+		values.Images(key, fileIds...)
+
+	case "embed":
+		ids, err := each(value, func(v interface{}) (int64, bool) {
+			switch v := v.(type) {
+			case Embed:
+				return int64(v.Id), true
+			case EmbedValue:
+				return int64(v.Embed.Id), true
+			}
+			return intOf(v)
+		})
+		if err != nil {
+			return err
+		}
+		embedIds := make([]int, len(ids))
+		for i, id := range ids {
+			embedIds[i] = int(id)
+		}
+		values.Embeds(key, embedIds...)
+
+	case "email":
+		emails, err := each(value, func(v interface{}) (EmailValue, bool) {
+			if e, ok := v.(EmailValue); ok {
+				return e, true
+			}
+			s, ok := stringOf(v)
+			return EmailValue{Type: "other", Value: s}, ok
+		})
+		if err != nil {
+			return err
+		}
+		values.Emails(key, emails...)
+
+	case "phone":
+		phones, err := each(value, func(v interface{}) (PhoneValue, bool) {
+			if p, ok := v.(PhoneValue); ok {
+				return p, true
+			}
+			s, ok := stringOf(v)
+			return PhoneValue{Type: "other", Value: s}, ok
+		})
+		if err != nil {
+			return err
+		}
+		values.Phones(key, phones...)
+
+	default:
+		return fmt.Errorf("cannot marshal fields of type %s", tag.typ)
+	}
+	return nil
+}
+
+var (
+	errNoDateStart      = errors.New("date value has no start")
+	errUnsupportedValue = errors.New("unsupported value")
+)
+
+// dateOf returns the value of a date field for v, errNoDateStart for dates without a start
+// and errUnsupportedValue for types that aren't dates
+func dateOf(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case time.Time:
+		if v.IsZero() {
+			return nil, errNoDateStart
+		}
+		return map[string]interface{}{"start_utc": v.UTC().Format(podioLayout)}, nil
+	case Time:
+		return dateOf(v.Time)
+	case Date:
+		if v.Start.IsZero() {
+			return nil, errNoDateStart
+		}
+		return dateFieldValue(v), nil
+	case DateValue:
+		if v.AllDay() {
+			allDay, err := v.In(time.UTC)
+			if err != nil {
+				return nil, err
+			}
+			return dateFieldValue(allDay), nil
+		}
+		if v.StartUTC == nil || *v.StartUTC == "" {
+			return nil, errNoDateStart
+		}
+		date := map[string]interface{}{"start_utc": *v.StartUTC}
+		if v.EndUTC != nil {
+			date["end_utc"] = *v.EndUTC
+		}
+		return date, nil
+	case DateValueSimple:
+		if v.Start == nil || *v.Start == "" {
+			return nil, errNoDateStart
+		}
+		return v, nil
+	case DateTimeValueSimple:
+		if v.Start.IsZero() {
+			return nil, errNoDateStart
+		}
+		date := map[string]interface{}{"start_utc": v.Start.UTC().Format(podioLayout)}
+		if !v.End.IsZero() {
+			date["end_utc"] = v.End.UTC().Format(podioLayout)
+		}
+		return date, nil
+	}
+	return nil, errUnsupportedValue
+}
+
+// single converts a value that is not a slice
+func single[T any](value reflect.Value, convert func(interface{}) (T, bool)) (T, error) {
+	var zero T
+	if value.Kind() == reflect.Slice {
+		if value.Len() != 1 {
+			return zero, fmt.Errorf("cannot marshal %d values of %s into a single value field", value.Len(), value.Type())
+		}
+		value = value.Index(0)
+	}
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return zero, fmt.Errorf("cannot marshal a nil %s", value.Type())
+		}
+		value = value.Elem()
+	}
+	converted, ok := convert(value.Interface())
+	if !ok {
+		return zero, fmt.Errorf("cannot marshal %s", value.Type())
+	}
+	return converted, nil
+}
+
+// each converts a value or every element of a slice
+func each[T any](value reflect.Value, convert func(interface{}) (T, bool)) ([]T, error) {
+	if value.Kind() != reflect.Slice {
+		converted, err := single(value, convert)
+		return []T{converted}, err
+	}
+	out := make([]T, 0, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		elem := value.Index(i)
+		for (elem.Kind() == reflect.Ptr || elem.Kind() == reflect.Interface) && !elem.IsNil() {
+			elem = elem.Elem()
+		}
+		if elem.Kind() == reflect.Ptr || elem.Kind() == reflect.Interface {
+			// nil elements are skipped
+			continue
+		}
+		converted, ok := convert(elem.Interface())
+		if !ok {
+			return nil, fmt.Errorf("cannot marshal %s", elem.Type())
+		}
+		out = append(out, converted)
+	}
+	return out, nil
+}
+
+func stringOf(v interface{}) (string, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.String {
+		return "", false
+	}
+	return rv.String(), true
+}
+
+func floatOf(v interface{}) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.CanInt():
+		return float64(rv.Int()), true
+	case rv.CanUint():
+		return float64(rv.Uint()), true
+	case rv.CanFloat():
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+func intOf(v interface{}) (int64, bool) {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.CanInt():
+		return rv.Int(), true
+	case rv.CanUint():
+		return int64(rv.Uint()), true
+	}
+	return 0, false
+}
@@ -0,0 +1,184 @@
+package podio
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mappedProject struct {
+	Title    string           `podio:"external_id=title,type=text"`
+	Status   []CategoryOption `podio:"external_id=status,type=category"`
+	StatusId int              `podio:"external_id=status"`
+	Budget   *MoneyValue      `podio:"external_id=budget,type=money"`
+	Hours    *float64         `podio:"external_id=hours,type=number"`
+	Deadline *time.Time       `podio:"field_id=16,type=date"`
+	Projects []ItemID         `podio:"external_id=project,type=app"`
+	Owners   []*Contact       `podio:"external_id=owner,type=contact"`
+	Emails   []EmailValue     `podio:"external_id=email,type=email"`
+	Notes    []TextValue      `podio:"external_id=notes,type=text"`
+	Ignored  string
+	When     Date `podio:"external_id=deadline"`
+}
+
+func TestUnmarshalItem(t *testing.T) {
+	r := require.New(t)
+
+	item := &ItemSimple{}
+	err := json.Unmarshal([]byte(`{
+		"item_id": 1,
+		"fields": [
+			{"field_id": 10, "external_id": "title", "type": "text", "values": [{"value": "Launch"}]},
+			{"field_id": 11, "external_id": "status", "type": "category", "values": [{"value": {"id": 2, "text": "Done"}}, {"value": {"id": 3, "text": "Open"}}]},
+			{"field_id": 13, "external_id": "project", "type": "app", "values": [{"value": {"item_id": 7}}, {"value": {"item_id": 8}}]},
+			{"field_id": 14, "external_id": "owner", "type": "contact", "values": [{"value": {"profile_id": 3, "name": "Ann"}}]},
+			{"field_id": 15, "external_id": "budget", "type": "money", "values": [{"value": "100.5", "currency": "EUR"}]},
+			{"field_id": 16, "external_id": "deadline", "type": "date", "values": [{"start_utc": "2024-03-01 10:00:00"}]},
+			{"field_id": 17, "external_id": "email", "type": "email", "values": [{"type": "work", "value": "a@example.com"}]}
+		]
+	}`), item)
+	r.NoError(err)
+
+	project := mappedProject{Title: "stale", Hours: new(float64)}
+	r.NoError(UnmarshalItem(item, &project))
+	r.Equal("Launch", project.Title)
+	r.Equal([]CategoryOption{{Id: 2, Text: "Done"}, {Id: 3, Text: "Open"}}, project.Status)
+	r.Equal(2, project.StatusId)
+	r.Equal(&MoneyValue{Value: 100.5, Currency: "EUR"}, project.Budget)
+	r.Nil(project.Hours)
+	r.Equal(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), *project.Deadline)
+	r.Equal([]ItemID{7, 8}, project.Projects)
+	r.Equal("Ann", project.Owners[0].Name)
+	r.Equal([]EmailValue{{Type: "work", Value: "a@example.com"}}, project.Emails)
+	r.Nil(project.Notes)
+	r.Equal(Date{Start: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)}, project.When)
+
+	var wrong struct {
+		Title string `podio:"external_id=title,type=number"`
+	}
+	var typeErr *FieldTypeError
+	r.ErrorAs(UnmarshalItem(item, &wrong), &typeErr)
+
+	var truncated struct {
+		Budget int `podio:"external_id=budget"`
+	}
+	r.ErrorContains(UnmarshalItem(item, &truncated), "cannot map 100.5 to int without losing its value")
+
+	var overflow struct {
+		Status int8 `podio:"external_id=status"`
+	}
+	big := &ItemSimple{Fields: []*Field{{PartialField: PartialField{Id: 1, ExternalId: "status", Type: "category"}, Values: []CategoryValue{{Value: CategoryOption{Id: 300}}}}}}
+	r.ErrorContains(UnmarshalItem(big, &overflow), "cannot map 300 to int8 without losing its value")
+
+	allDayItem := &ItemSimple{Fields: []*Field{{PartialField: PartialField{Id: 1, ExternalId: "day", Type: "date"}, Values: []DateValue{{StartDate: ptrTo("2024-03-01")}}}}}
+	var allDayTime struct {
+		Day *time.Time `podio:"external_id=day"`
+	}
+	r.ErrorContains(UnmarshalItem(allDayItem, &allDayTime), "cannot map an all-day date to time.Time")
+	var allDay struct {
+		Day *Date `podio:"external_id=day,type=date"`
+	}
+	r.NoError(UnmarshalItem(allDayItem, &allDay))
+	r.True(allDay.Day.AllDay)
+	fields, err := MarshalItemValues(allDay)
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"start_date": "2024-03-01"}, fields["day"])
+
+	var unmappable struct {
+		Title bool `podio:"external_id=title"`
+	}
+	r.ErrorContains(UnmarshalItem(item, &unmappable), "cannot map podio.TextValue to bool")
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
+
+func TestMarshalItemValues(t *testing.T) {
+	r := require.New(t)
+
+	hours := 7.5
+	deadline := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	fields, err := MarshalItemValues(&mappedProject{
+		Title:    "Launch",
+		Status:   []CategoryOption{{Id: 2}},
+		Budget:   &MoneyValue{Value: 100.5, Currency: "EUR"},
+		Hours:    &hours,
+		Deadline: &deadline,
+		Projects: []ItemID{7, 8},
+		Owners:   []*Contact{{ProfileId: 3}},
+		Emails:   []EmailValue{},
+	})
+	r.ErrorContains(err, "mappedProject.StatusId: podio tag needs a type")
+
+	var project struct {
+		Title    string           `podio:"external_id=title,type=text"`
+		Status   []CategoryOption `podio:"external_id=status,type=category"`
+		Budget   *MoneyValue      `podio:"external_id=budget,type=money"`
+		Amount   float64          `podio:"external_id=amount,type=money,currency=DKK"`
+		Hours    *float64         `podio:"external_id=hours,type=number"`
+		Deadline *time.Time       `podio:"field_id=16,type=date"`
+		Projects []ItemID         `podio:"external_id=project,type=app"`
+		Owners   []*Contact       `podio:"external_id=owner,type=contact"`
+		Emails   []EmailValue     `podio:"external_id=email,type=email"`
+		Phones   []string         `podio:"external_id=phone,type=phone"`
+		Total    float64          `podio:"external_id=total,type=calculation"`
+	}
+	project.Title = "Launch"
+	project.Status = []CategoryOption{{Id: 2}}
+	project.Amount = 50
+	project.Hours = &hours
+	project.Deadline = &deadline
+	project.Projects = []ItemID{7, 8}
+	project.Owners = []*Contact{{ProfileId: 3}}
+	project.Emails = []EmailValue{}
+	project.Phones = []string{"+45 1234"}
+
+	fields, err = MarshalItemValues(project)
+	r.NoError(err)
+	buf, err := json.Marshal(fields)
+	r.NoError(err)
+	r.Equal(`{"16":{"start_utc":"2024-03-01 10:00:00"},"amount":{"currency":"DKK","value":"50"},"email":[],"hours":7.5,"owner":[3],"phone":[{"value":"+45 1234","type":"other"}],"project":[7,8],"status":[2],"title":"Launch"}`, string(buf))
+}
+
+func TestMarshalItemValuesZeroAndInvalidValues(t *testing.T) {
+	r := require.New(t)
+
+	var unset struct {
+		Title    string    `podio:"external_id=title,type=text"`
+		Status   int       `podio:"external_id=status,type=category"`
+		Deadline time.Time `podio:"external_id=deadline,type=date"`
+		Done     int       `podio:"external_id=done,type=progress,keepzero"`
+		Hours    *float64  `podio:"external_id=hours,type=number"`
+		Projects []*Item   `podio:"external_id=project,type=app"`
+	}
+	unset.Hours = new(float64)
+	unset.Projects = []*Item{nil, {Id: 7}}
+	fields, err := MarshalItemValues(unset)
+	r.NoError(err)
+	r.Equal(map[string]interface{}{"done": 0, "hours": 0.0, "project": []ItemID{7}}, fields)
+
+	var progress struct {
+		Done float64 `podio:"external_id=done,type=progress"`
+	}
+	progress.Done = 40.5
+	_, err = MarshalItemValues(progress)
+	r.ErrorContains(err, "progress must be a whole number from 0 to 100, got 40.5")
+	progress.Done = 300
+	_, err = MarshalItemValues(progress)
+	r.ErrorContains(err, "got 300")
+
+	var date struct {
+		Deadline DateValue `podio:"external_id=deadline,type=date"`
+		Meeting  *Date     `podio:"external_id=meeting,type=date"`
+	}
+	date.Deadline = DateValue{EndUTC: ptrTo("2024-03-01 10:00:00")}
+	_, err = MarshalItemValues(date)
+	r.ErrorContains(err, "date value has no start")
+	date.Deadline = DateValue{}
+	date.Meeting = &Date{}
+	_, err = MarshalItemValues(date)
+	r.ErrorContains(err, "date value has no start")
+}
@@ -0,0 +1,241 @@
+package podio
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metrics receives one observation per API call, see WithMetrics
+type Metrics interface {
+	ObserveRequest(m RequestMetric)
+}
+
+// RequestMetric describes a finished API call (including its retries)
+type RequestMetric struct {
+	Method string
+	// Route is the path with ids replaced, e.g. /item/{id} or /item/app/{id}/filter
+	Route string
+	// StatusCode is 0 when no response was received
+	StatusCode int
+	Duration   time.Duration
+	// RateLimit and RateLimitRemaining are 0 when the response had no rate limit headers
+	RateLimit          int
+	RateLimitRemaining int
+	Err                error
+}
+
+// WithMetrics reports every API call of the client to metrics
+func WithMetrics(metrics Metrics) ClientOption {
+	return func(client *Client) {
+		client.metrics = metrics
+	}
+}
+
+var numericSegment = regexp.MustCompile(`^\d+$`)
+
+// routeTemplates replace the non numeric variable parts of paths, applied in order after ids
+// became {id}. The segments in keep are part of other routes and stay as they are.
+var routeTemplates = []struct {
+	prefix   []string
+	variable string
+	keep     []string
+}{
+	{[]string{"item", "app", "{id}", "external_id"}, "{external_id}", nil},
+	{[]string{"app", "{id}", "item"}, "{app_item_id}", nil},
+	{[]string{"app", "{id}", "field"}, "{field}", nil},
+	{[]string{"item", "{id}", "value"}, "{field}", []string{"v2"}},
+	{[]string{"app", "space", "{id}"}, "{url_label}", nil},
+	{[]string{"app", "org"}, "{org_label}", nil},
+	{[]string{"app", "org", "{org_label}"}, "{app_label}", []string{"space"}},
+	{[]string{"app", "org", "{org_label}", "space"}, "{space_label}", nil},
+	{[]string{"app", "org", "{org_label}", "space", "{space_label}"}, "{app_label}", nil},
+	{[]string{"space", "org", "{id}"}, "{url_label}", nil},
+	{[]string{"view", "app", "{id}"}, "{view}", nil},
+}
+
+// RouteTemplate normalizes an API path for metrics: the query is dropped and ids,
+// external ids and url labels are replaced by placeholders.
+func RouteTemplate(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if numericSegment.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+
+	for _, tmpl := range routeTemplates {
+		n := len(tmpl.prefix)
+		if len(segments) > n && equalSegments(segments[:n], tmpl.prefix) && !contains(tmpl.keep, segments[n]) {
+			segments[n] = tmpl.variable
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func equalSegments(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DefaultDurationBuckets are the histogram buckets (in seconds) of a MetricsCollector
+var DefaultDurationBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// MetricsCollector is an in-process Metrics implementation that can be exposed
+// in the Prometheus text format, it is an http.Handler for a /metrics endpoint.
+type MetricsCollector struct {
+	buckets []float64
+
+	mu                 sync.Mutex
+	requests           map[requestKey]int
+	durations          map[routeKey]*histogram
+	rateLimitRemaining map[int]int // by limit, Podio has one for regular and one for "rate limited" operations
+}
+
+type requestKey struct {
+	method string
+	route  string
+	status string
+}
+
+type routeKey struct {
+	method string
+	route  string
+}
+
+type histogram struct {
+	counts []int // per bucket, not cumulative
+	count  int
+	sum    float64
+}
+
+func NewMetricsCollector() *MetricsCollector {
+	return &MetricsCollector{
+		buckets:   DefaultDurationBuckets,
+		requests:  map[requestKey]int{},
+		durations: map[routeKey]*histogram{},
+
+		rateLimitRemaining: map[int]int{},
+	}
+}
+
+func (c *MetricsCollector) ObserveRequest(m RequestMetric) {
+	status := "error"
+	if m.StatusCode != 0 {
+		status = strconv.Itoa(m.StatusCode)
+	}
+	seconds := m.Duration.Seconds()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requests[requestKey{m.Method, m.Route, status}]++
+
+	h, ok := c.durations[routeKey{m.Method, m.Route}]
+	if !ok {
+		h = &histogram{counts: make([]int, len(c.buckets))}
+		c.durations[routeKey{m.Method, m.Route}] = h
+	}
+	for i, bound := range c.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += seconds
+
+	if m.RateLimit > 0 {
+		c.rateLimitRemaining[m.RateLimit] = m.RateLimitRemaining
+	}
+}
+
+// WritePrometheus writes all metrics in the Prometheus text exposition format
+func (c *MetricsCollector) WritePrometheus(w io.Writer) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var b strings.Builder
+
+	b.WriteString("# HELP podio_requests_total Podio API calls by method, route and status.\n")
+	b.WriteString("# TYPE podio_requests_total counter\n")
+	requestKeys := make([]requestKey, 0, len(c.requests))
+	for key := range c.requests {
+		requestKeys = append(requestKeys, key)
+	}
+	sort.Slice(requestKeys, func(i, j int) bool {
+		a, b := requestKeys[i], requestKeys[j]
+		if a.route != b.route {
+			return a.route < b.route
+		}
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		return a.status < b.status
+	})
+	for _, key := range requestKeys {
+		fmt.Fprintf(&b, "podio_requests_total{method=%q,route=%q,status=%q} %d\n", key.method, key.route, key.status, c.requests[key])
+	}
+
+	b.WriteString("# HELP podio_request_duration_seconds Duration of Podio API calls, including retries.\n")
+	b.WriteString("# TYPE podio_request_duration_seconds histogram\n")
+	routeKeys := make([]routeKey, 0, len(c.durations))
+	for key := range c.durations {
+		routeKeys = append(routeKeys, key)
+	}
+	sort.Slice(routeKeys, func(i, j int) bool {
+		a, b := routeKeys[i], routeKeys[j]
+		if a.route != b.route {
+			return a.route < b.route
+		}
+		return a.method < b.method
+	})
+	for _, key := range routeKeys {
+		h := c.durations[key]
+		cumulative := 0
+		for i, bound := range c.buckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(&b, "podio_request_duration_seconds_bucket{method=%q,route=%q,le=%q} %d\n", key.method, key.route, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(&b, "podio_request_duration_seconds_bucket{method=%q,route=%q,le=\"+Inf\"} %d\n", key.method, key.route, h.count)
+		fmt.Fprintf(&b, "podio_request_duration_seconds_sum{method=%q,route=%q} %s\n", key.method, key.route, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "podio_request_duration_seconds_count{method=%q,route=%q} %d\n", key.method, key.route, h.count)
+	}
+
+	limits := make([]int, 0, len(c.rateLimitRemaining))
+	for limit := range c.rateLimitRemaining {
+		limits = append(limits, limit)
+	}
+	sort.Ints(limits)
+	b.WriteString("# HELP podio_rate_limit Podio rate limits seen, one series per limit.\n")
+	b.WriteString("# TYPE podio_rate_limit gauge\n")
+	for _, limit := range limits {
+		fmt.Fprintf(&b, "podio_rate_limit{limit=\"%d\"} %d\n", limit, limit)
+	}
+	b.WriteString("# HELP podio_rate_limit_remaining Last seen remaining Podio rate limit, one series per limit.\n")
+	b.WriteString("# TYPE podio_rate_limit_remaining gauge\n")
+	for _, limit := range limits {
+		fmt.Fprintf(&b, "podio_rate_limit_remaining{limit=\"%d\"} %d\n", limit, c.rateLimitRemaining[limit])
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (c *MetricsCollector) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	c.WritePrometheus(w)
+}
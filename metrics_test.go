@@ -0,0 +1,59 @@
+package podio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteTemplate(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("/item/{id}", RouteTemplate("/item/123?fields=files"))
+	r.Equal("/item/app/{id}/filter", RouteTemplate("/item/app/42/filter?fields=items.fields(files,tags)"))
+	r.Equal("/item/app/{id}/external_id/{external_id}", RouteTemplate("/item/app/42/external_id/abc"))
+	r.Equal("/app/{id}/item/{app_item_id}", RouteTemplate("/app/42/item/INV-0001"))
+	r.Equal("/comment/item/{id}", RouteTemplate("/comment/item/7/"))
+	r.Equal("/app/{id}/field/{field}", RouteTemplate("/app/42/field/status"))
+	r.Equal("/app/{id}/field/{field}", RouteTemplate("/app/42/field/7"))
+	r.Equal("/item/{id}/value/{field}", RouteTemplate("/item/7/value/due-date"))
+	r.Equal("/item/{id}/value/{field}/v2", RouteTemplate("/item/7/value/due-date/v2"))
+	r.Equal("/item/{id}/value/v2", RouteTemplate("/item/7/value/v2"))
+	r.Equal("/app/org/{org_label}/{app_label}", RouteTemplate("/app/org/acme/projects"))
+	r.Equal("/app/org/{org_label}/space/{space_label}/{app_label}", RouteTemplate("/app/org/acme/space/sales/projects"))
+}
+
+func TestMetricsCollector(t *testing.T) {
+	r := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-Rate-Limit-Limit", "5000")
+		w.Header().Set("X-Rate-Limit-Remaining", "4000")
+		w.Write([]byte(`{"item_id": 1}`))
+	}))
+	defer server.Close()
+
+	collector := NewMetricsCollector()
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL), WithMetrics(collector))
+
+	_, err := client.GetItem(1)
+	r.NoError(err)
+	_, err = client.GetItem(2)
+	r.NoError(err)
+
+	var out strings.Builder
+	r.NoError(collector.WritePrometheus(&out))
+	r.Contains(out.String(), `podio_requests_total{method="GET",route="/item/{id}",status="200"} 2`)
+	r.Contains(out.String(), `podio_request_duration_seconds_count{method="GET",route="/item/{id}"} 2`)
+	r.Contains(out.String(), `podio_rate_limit_remaining{limit="5000"} 4000`)
+
+	collector.ObserveRequest(RequestMetric{Method: "POST", Route: "/item/app/{id}/filter", StatusCode: 200, RateLimit: 1000, RateLimitRemaining: 200})
+	out.Reset()
+	r.NoError(collector.WritePrometheus(&out))
+	r.Contains(out.String(), `podio_rate_limit{limit="1000"} 1000`)
+	r.Contains(out.String(), `podio_rate_limit_remaining{limit="1000"} 200`)
+	r.Contains(out.String(), `podio_rate_limit_remaining{limit="5000"} 4000`)
+}
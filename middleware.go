@@ -0,0 +1,82 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+)
+
+// Doer sends an HTTP request, *http.Client implements it
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc turns a function into a Doer
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer that sends the requests of a client, e.g. for logging:
+//
+//	func logRequests(next podio.Doer) podio.Doer {
+//		return podio.DoerFunc(func(req *http.Request) (*http.Response, error) {
+//			start := time.Now()
+//			resp, err := next.Do(req)
+//			info := podio.RequestInfoFromContext(req.Context())
+//			log.Println(info.Method, info.Path, time.Since(start), err)
+//			return resp, err
+//		})
+//	}
+//
+// Middleware sees every attempt of API requests as well as auth and file download requests.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware adds middleware to the client, the first one is the outermost
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(client *Client) {
+		client.middleware = append(client.middleware, middleware...)
+	}
+}
+
+// Kinds of requests in RequestInfo
+const (
+	RequestKindAPI  = "api"
+	RequestKindAuth = "auth"
+	RequestKindFile = "file"
+)
+
+// RequestInfo describes the request from the client's point of view,
+// middleware gets it with RequestInfoFromContext(req.Context())
+type RequestInfo struct {
+	Kind   string
+	Method string
+	// Path is the API path as passed to Request (for file downloads the url without oauth_token)
+	Path string
+	// Params are the params given to RequestWithParams, nil for other requests
+	Params map[string]interface{}
+	// Attempt counts from 1 and increases with every retry of the same call
+	Attempt int
+}
+
+type requestInfoKey struct{}
+
+// RequestInfoFromContext returns the RequestInfo of a request sent by a Client, or nil
+func RequestInfoFromContext(ctx context.Context) *RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(*RequestInfo)
+	return info
+}
+
+func withRequestInfo(ctx context.Context, info *RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+type requestParamsKey struct{}
+
+func buildDoer(httpClient *http.Client, middleware []Middleware) Doer {
+	var doer Doer = httpClient
+	for i := len(middleware) - 1; i >= 0; i-- {
+		doer = middleware[i](doer)
+	}
+	return doer
+}
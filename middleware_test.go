@@ -0,0 +1,68 @@
+package podio
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewareSeesRequests(t *testing.T) {
+	r := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("X-Signed") != "yes" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"access_token": "token"}`))
+	}))
+	defer server.Close()
+
+	var seen []RequestInfo
+	record := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			seen = append(seen, *RequestInfoFromContext(req.Context()))
+			return next.Do(req)
+		})
+	}
+	sign := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Signed", "yes")
+			return next.Do(req)
+		})
+	}
+	opts := []ClientOption{WithBaseURL(server.URL), WithMiddleware(record, sign)}
+
+	token, err := AuthWithAppCredentials("id", "secret", 1, "app-token", opts...)
+	r.NoError(err)
+
+	client := NewClient(token, opts...)
+	_, err = client.FilterItems(1, map[string]interface{}{"limit": 10})
+	r.NoError(err)
+
+	r.Len(seen, 2)
+	r.Equal(RequestKindAuth, seen[0].Kind)
+	r.Equal(RequestKindAPI, seen[1].Kind)
+	r.Equal("POST", seen[1].Method)
+	r.Equal("/item/app/1/filter?fields=items.fields(files,tags)", seen[1].Path)
+	r.Equal(10, seen[1].Params["limit"])
+	r.Equal(1, seen[1].Attempt)
+}
+
+func TestMiddlewareFaultInjection(t *testing.T) {
+	r := require.New(t)
+
+	injected := errors.New("injected")
+	fail := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, injected
+		})
+	}
+
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithMiddleware(fail))
+	_, err := client.GetUser()
+	r.True(errors.Is(err, injected))
+}
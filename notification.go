@@ -1,10 +1,18 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // https://developers.podio.com/doc/notifications/mark-notifications-as-viewed-by-ref-553653
 func (client *Client) NotificationMarkAsViewedForRef(refType string, refId int64) (statusCode int, err error) {
+	return client.NotificationMarkAsViewedForRefCtx(context.Background(), refType, refId)
+}
+
+// NotificationMarkAsViewedForRefCtx is the context-aware version of NotificationMarkAsViewedForRef.
+func (client *Client) NotificationMarkAsViewedForRefCtx(ctx context.Context, refType string, refId int64) (statusCode int, err error) {
 	path := fmt.Sprintf("/notification/%s/%d/viewed", refType, refId)
-	err = client.Request("POST", path, nil, nil, &statusCode)
+	err = client.RequestCtx(ctx, "POST", path, nil, nil, &statusCode)
 	return
 }
@@ -1,6 +1,9 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Organization struct {
 	Id        int64    `json:"org_id"`
@@ -21,20 +24,35 @@ type OrgImage struct {
 
 // https://developers.podio.com/doc/organizations/get-organizations-22344
 func (client *Client) GetOrganizations() (orgs []Organization, err error) {
-	err = client.Request("GET", "/org", nil, nil, &orgs)
+	return client.GetOrganizationsCtx(context.Background())
+}
+
+// GetOrganizationsCtx is the context-aware version of GetOrganizations.
+func (client *Client) GetOrganizationsCtx(ctx context.Context) (orgs []Organization, err error) {
+	err = client.RequestCtx(ctx, "GET", "/org", nil, nil, &orgs)
 	return
 }
 
 // https://developers.podio.com/doc/organizations/get-organization-22383
 func (client *Client) GetOrganization(id int64) (org *Organization, err error) {
+	return client.GetOrganizationCtx(context.Background(), id)
+}
+
+// GetOrganizationCtx is the context-aware version of GetOrganization.
+func (client *Client) GetOrganizationCtx(ctx context.Context, id int64) (org *Organization, err error) {
 	path := fmt.Sprintf("/org/%d", id)
-	err = client.Request("GET", path, nil, nil, &org)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &org)
 	return
 }
 
 // https://developers.podio.com/doc/organizations/get-organization-by-url-22384
 func (client *Client) GetOrganizationBySlug(slug string) (org *Organization, err error) {
+	return client.GetOrganizationBySlugCtx(context.Background(), slug)
+}
+
+// GetOrganizationBySlugCtx is the context-aware version of GetOrganizationBySlug.
+func (client *Client) GetOrganizationBySlugCtx(ctx context.Context, slug string) (org *Organization, err error) {
 	path := fmt.Sprintf("/org/url?org_slug=%s", slug)
-	err = client.Request("GET", path, nil, nil, &org)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &org)
 	return
 }
@@ -0,0 +1,197 @@
+package podio
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// the largest pages of the list endpoints with a Paginator
+const (
+	maxTaskLimit        = 100
+	maxCommentLimit     = 100
+	maxStreamLimit      = 100
+	maxFileLimit        = 100
+	maxSpaceMemberLimit = 500
+	maxTagLimit         = 100
+)
+
+// IterateOption configures the item iterators and the paginators, see IterateItems and Paginator.
+type IterateOption func(*iterateOptions)
+
+type iterateOptions struct {
+	pageSize   int
+	offset     int
+	maxResults int
+	prefetch   bool
+}
+
+// PageSize sets the number of results requested per page. It defaults to and is capped
+// at the largest page the endpoint allows.
+func PageSize(size int) IterateOption {
+	return func(options *iterateOptions) {
+		options.pageSize = size
+	}
+}
+
+// FromOffset skips the first offset results.
+func FromOffset(offset int) IterateOption {
+	return func(options *iterateOptions) {
+		options.offset = offset
+	}
+}
+
+// MaxResults stops after max results, no more pages than needed are requested.
+func MaxResults(max int) IterateOption {
+	return func(options *iterateOptions) {
+		options.maxResults = max
+	}
+}
+
+// Prefetch requests the next page in the background while the current page is consumed.
+func Prefetch() IterateOption {
+	return func(options *iterateOptions) {
+		options.prefetch = true
+	}
+}
+
+func newIterateOptions(maxPageSize int, opts []IterateOption) iterateOptions {
+	options := iterateOptions{pageSize: maxPageSize}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.pageSize <= 0 || options.pageSize > maxPageSize {
+		options.pageSize = maxPageSize
+	}
+	return options
+}
+
+// PageFunc fetches the page of limit results starting at offset.
+type PageFunc[T any] func(ctx context.Context, limit, offset int) ([]T, error)
+
+// Paginator pages through a list endpoint with limit and offset. A page with fewer
+// results than requested is the last one.
+//
+//	contacts, err := client.PaginateContacts(podio.PageSize(100)).Collect()
+type Paginator[T any] struct {
+	fetch   PageFunc[T]
+	options iterateOptions
+}
+
+// NewPaginator returns a Paginator for fetch, maxPageSize is the largest page the endpoint allows.
+func NewPaginator[T any](fetch PageFunc[T], maxPageSize int, opts ...IterateOption) *Paginator[T] {
+	return &Paginator[T]{fetch: fetch, options: newIterateOptions(maxPageSize, opts)}
+}
+
+// All iterates over the results of all pages, stopping at the first error.
+func (p *Paginator[T]) All() iter.Seq2[T, error] {
+	return p.AllCtx(context.Background())
+}
+
+// AllCtx is the context-aware version of All.
+func (p *Paginator[T]) AllCtx(ctx context.Context) iter.Seq2[T, error] {
+	return iteratePages(ctx, p.options, func(ctx context.Context, limit, offset int) ([]T, bool, error) {
+		results, err := p.fetch(ctx, limit, offset)
+		return results, len(results) == limit, err
+	})
+}
+
+// Collect returns the results of all pages.
+func (p *Paginator[T]) Collect() ([]T, error) {
+	return p.CollectCtx(context.Background())
+}
+
+// CollectCtx is the context-aware version of Collect.
+func (p *Paginator[T]) CollectCtx(ctx context.Context) (results []T, err error) {
+	for result, err := range p.AllCtx(ctx) {
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// pageParams copies params with the limit and offset of a page
+func pageParams(params map[string]interface{}, limit, offset int) map[string]interface{} {
+	paged := make(map[string]interface{}, len(params)+2)
+	for key, value := range params {
+		paged[key] = value
+	}
+	paged["limit"] = limit
+	paged["offset"] = offset
+	return paged
+}
+
+type page[T any] struct {
+	results []T
+	more    bool
+	err     error
+}
+
+// iteratePages pages with limit and offset, fetch returns the results of a page and
+// whether there are more pages. Every page is a regular request, so it waits for the
+// rate limiter and fails with the error of a cancelled context.
+func iteratePages[T any](ctx context.Context, options iterateOptions, fetch func(ctx context.Context, limit, offset int) ([]T, bool, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var prefetching sync.WaitGroup
+		// stop a prefetch when the loop breaks early and wait for it to finish
+		defer prefetching.Wait()
+		defer cancel()
+
+		yielded := 0
+		limitAt := func(offset int) int {
+			if options.maxResults > 0 && options.maxResults-(offset-options.offset) < options.pageSize {
+				return options.maxResults - (offset - options.offset)
+			}
+			return options.pageSize
+		}
+		fetchPage := func(offset int) page[T] {
+			results, more, err := fetch(ctx, limitAt(offset), offset)
+			return page[T]{results: results, more: more, err: err}
+		}
+
+		offset := options.offset
+		current := fetchPage(offset)
+		for {
+			if current.err != nil {
+				var zero T
+				yield(zero, current.err)
+				return
+			}
+			offset += len(current.results)
+			more := current.more && len(current.results) > 0 &&
+				(options.maxResults <= 0 || yielded+len(current.results) < options.maxResults)
+
+			var next chan page[T]
+			if more && options.prefetch {
+				next = make(chan page[T], 1)
+				prefetching.Add(1)
+				go func(offset int) {
+					defer prefetching.Done()
+					next <- fetchPage(offset)
+				}(offset)
+			}
+
+			for _, result := range current.results {
+				if options.maxResults > 0 && yielded == options.maxResults {
+					return
+				}
+				if !yield(result, nil) {
+					return
+				}
+				yielded++
+			}
+			if !more {
+				return
+			}
+
+			if next != nil {
+				current = <-next
+			} else {
+				current = fetchPage(offset)
+			}
+		}
+	}
+}
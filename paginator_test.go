@@ -0,0 +1,94 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// listPages serves the numbers 0 to n-1 and records the requested pages
+func listPages(n int, requested *[][2]int) PageFunc[int] {
+	return func(ctx context.Context, limit, offset int) ([]int, error) {
+		*requested = append(*requested, [2]int{limit, offset})
+		var results []int
+		for i := offset; i < n && i < offset+limit; i++ {
+			results = append(results, i)
+		}
+		return results, nil
+	}
+}
+
+func TestPaginator(t *testing.T) {
+	r := require.New(t)
+
+	var requested [][2]int
+	results, err := NewPaginator(listPages(7, &requested), 100, PageSize(3)).Collect()
+	r.NoError(err)
+	r.Equal([]int{0, 1, 2, 3, 4, 5, 6}, results)
+	r.Equal([][2]int{{3, 0}, {3, 3}, {3, 6}}, requested)
+
+	requested = nil
+	results, err = NewPaginator(listPages(20, &requested), 100, PageSize(3), FromOffset(5), MaxResults(4), Prefetch()).Collect()
+	r.NoError(err)
+	r.Equal([]int{5, 6, 7, 8}, results)
+	r.Equal([][2]int{{3, 5}, {1, 8}}, requested)
+
+	requested = nil
+	_, err = NewPaginator(listPages(20, &requested), 10, PageSize(50)).Collect()
+	r.NoError(err)
+	r.Equal([2]int{10, 0}, requested[0])
+}
+
+func TestPaginateContacts(t *testing.T) {
+	r := require.New(t)
+
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		offsets = append(offsets, query.Get("offset"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		contacts := []Contact{}
+		for i := offset; i < 5 && i < offset+limit; i++ {
+			contacts = append(contacts, Contact{ProfileId: ProfileID(i + 1)})
+		}
+		json.NewEncoder(w).Encode(contacts)
+	}))
+	defer server.Close()
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+	contacts, err := client.PaginateContacts(PageSize(2)).Collect()
+	r.NoError(err)
+	r.Len(contacts, 5)
+	r.Equal([]string{"0", "2", "4"}, offsets)
+}
+
+func TestPaginateTagsForApp(t *testing.T) {
+	r := require.New(t)
+
+	var limits []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		limits = append(limits, req.URL.Query().Get("limit"))
+		limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+		tags := []Tag{}
+		for i := 0; i < 5 && i < limit; i++ {
+			tags = append(tags, Tag{Text: fmt.Sprint("tag", i)})
+		}
+		json.NewEncoder(w).Encode(tags)
+	}))
+	defer server.Close()
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+	tags, err := client.PaginateTagsForApp(1, "", PageSize(2)).Collect()
+	r.NoError(err)
+	r.Len(tags, 5)
+	r.Equal("tag0", tags[0].Text)
+	r.Equal("tag4", tags[4].Text)
+	r.Equal([]string{"2", "4", "6"}, limits)
+}
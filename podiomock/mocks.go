@@ -0,0 +1,1785 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package podiomock
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"sync"
+
+	"github.com/andreas/podio-go"
+)
+
+// AppService is a mock of podio.AppService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type AppService struct {
+	GetAppsFunc                 func(ctx context.Context, spaceId podio.SpaceID, options map[string]interface{}) (apps []*podio.App, err error)
+	GetAppFunc                  func(ctx context.Context, id podio.AppID) (app *podio.App, err error)
+	GetAppBySpaceIdAndSlugFunc  func(ctx context.Context, spaceId podio.SpaceID, slug string) (app *podio.App, err error)
+	GetSpaceDependenciesFunc    func(ctx context.Context, spaceId podio.SpaceID) (response *interface{}, err error)
+	CreateAppFunc               func(ctx context.Context, spaceId podio.SpaceID, config map[string]interface{}, fields []podio.AppField) (AppId podio.AppID, err error)
+	UpdateAppFunc               func(ctx context.Context, appId podio.AppID, config map[string]interface{}) (err error)
+	UpdateAppRawFunc            func(ctx context.Context, appId podio.AppID, configRaw json.RawMessage) (err error)
+	InstallAppFunc              func(ctx context.Context, appId podio.AppID, spaceId podio.SpaceID, features []string) (AppId podio.AppID, err error)
+	CreateAppFieldFunc          func(ctx context.Context, appId podio.AppID, params map[string]interface{}) (AppFieldId podio.FieldID, err error)
+	CreateAppFieldRawConfigFunc func(ctx context.Context, appId podio.AppID, config json.RawMessage) (AppFieldId podio.FieldID, err error)
+	UpdateAppFieldFunc          func(ctx context.Context, appId podio.AppID, appFieldId podio.FieldID, params map[string]interface{}) (revision int, err error)
+	UpdateAppFieldRawConfigFunc func(ctx context.Context, appId podio.AppID, appFieldId podio.FieldID, config json.RawMessage) (int, error)
+	GetFieldRangeFunc           func(ctx context.Context, fieldID podio.FieldID) (podio.FieldRange, error)
+	GetFormsFunc                func(ctx context.Context, appId podio.AppID) (forms []*podio.Form, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.AppService = (*AppService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *AppService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *AppService) GetApps(ctx context.Context, spaceId podio.SpaceID, options map[string]interface{}) (apps []*podio.App, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetApps", Args: []interface{}{ctx, spaceId, options}})
+	m.mu.Unlock()
+
+	if m.GetAppsFunc == nil {
+		panic("podiomock: AppService.GetApps called without GetAppsFunc")
+	}
+	return m.GetAppsFunc(ctx, spaceId, options)
+}
+
+func (m *AppService) GetApp(ctx context.Context, id podio.AppID) (app *podio.App, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetApp", Args: []interface{}{ctx, id}})
+	m.mu.Unlock()
+
+	if m.GetAppFunc == nil {
+		panic("podiomock: AppService.GetApp called without GetAppFunc")
+	}
+	return m.GetAppFunc(ctx, id)
+}
+
+func (m *AppService) GetAppBySpaceIdAndSlug(ctx context.Context, spaceId podio.SpaceID, slug string) (app *podio.App, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetAppBySpaceIdAndSlug", Args: []interface{}{ctx, spaceId, slug}})
+	m.mu.Unlock()
+
+	if m.GetAppBySpaceIdAndSlugFunc == nil {
+		panic("podiomock: AppService.GetAppBySpaceIdAndSlug called without GetAppBySpaceIdAndSlugFunc")
+	}
+	return m.GetAppBySpaceIdAndSlugFunc(ctx, spaceId, slug)
+}
+
+func (m *AppService) GetSpaceDependencies(ctx context.Context, spaceId podio.SpaceID) (response *interface{}, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetSpaceDependencies", Args: []interface{}{ctx, spaceId}})
+	m.mu.Unlock()
+
+	if m.GetSpaceDependenciesFunc == nil {
+		panic("podiomock: AppService.GetSpaceDependencies called without GetSpaceDependenciesFunc")
+	}
+	return m.GetSpaceDependenciesFunc(ctx, spaceId)
+}
+
+func (m *AppService) CreateApp(ctx context.Context, spaceId podio.SpaceID, config map[string]interface{}, fields []podio.AppField) (AppId podio.AppID, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateApp", Args: []interface{}{ctx, spaceId, config, fields}})
+	m.mu.Unlock()
+
+	if m.CreateAppFunc == nil {
+		panic("podiomock: AppService.CreateApp called without CreateAppFunc")
+	}
+	return m.CreateAppFunc(ctx, spaceId, config, fields)
+}
+
+func (m *AppService) UpdateApp(ctx context.Context, appId podio.AppID, config map[string]interface{}) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateApp", Args: []interface{}{ctx, appId, config}})
+	m.mu.Unlock()
+
+	if m.UpdateAppFunc == nil {
+		panic("podiomock: AppService.UpdateApp called without UpdateAppFunc")
+	}
+	return m.UpdateAppFunc(ctx, appId, config)
+}
+
+func (m *AppService) UpdateAppRaw(ctx context.Context, appId podio.AppID, configRaw json.RawMessage) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateAppRaw", Args: []interface{}{ctx, appId, configRaw}})
+	m.mu.Unlock()
+
+	if m.UpdateAppRawFunc == nil {
+		panic("podiomock: AppService.UpdateAppRaw called without UpdateAppRawFunc")
+	}
+	return m.UpdateAppRawFunc(ctx, appId, configRaw)
+}
+
+func (m *AppService) InstallApp(ctx context.Context, appId podio.AppID, spaceId podio.SpaceID, features []string) (AppId podio.AppID, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "InstallApp", Args: []interface{}{ctx, appId, spaceId, features}})
+	m.mu.Unlock()
+
+	if m.InstallAppFunc == nil {
+		panic("podiomock: AppService.InstallApp called without InstallAppFunc")
+	}
+	return m.InstallAppFunc(ctx, appId, spaceId, features)
+}
+
+func (m *AppService) CreateAppField(ctx context.Context, appId podio.AppID, params map[string]interface{}) (AppFieldId podio.FieldID, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateAppField", Args: []interface{}{ctx, appId, params}})
+	m.mu.Unlock()
+
+	if m.CreateAppFieldFunc == nil {
+		panic("podiomock: AppService.CreateAppField called without CreateAppFieldFunc")
+	}
+	return m.CreateAppFieldFunc(ctx, appId, params)
+}
+
+func (m *AppService) CreateAppFieldRawConfig(ctx context.Context, appId podio.AppID, config json.RawMessage) (AppFieldId podio.FieldID, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateAppFieldRawConfig", Args: []interface{}{ctx, appId, config}})
+	m.mu.Unlock()
+
+	if m.CreateAppFieldRawConfigFunc == nil {
+		panic("podiomock: AppService.CreateAppFieldRawConfig called without CreateAppFieldRawConfigFunc")
+	}
+	return m.CreateAppFieldRawConfigFunc(ctx, appId, config)
+}
+
+func (m *AppService) UpdateAppField(ctx context.Context, appId podio.AppID, appFieldId podio.FieldID, params map[string]interface{}) (revision int, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateAppField", Args: []interface{}{ctx, appId, appFieldId, params}})
+	m.mu.Unlock()
+
+	if m.UpdateAppFieldFunc == nil {
+		panic("podiomock: AppService.UpdateAppField called without UpdateAppFieldFunc")
+	}
+	return m.UpdateAppFieldFunc(ctx, appId, appFieldId, params)
+}
+
+func (m *AppService) UpdateAppFieldRawConfig(ctx context.Context, appId podio.AppID, appFieldId podio.FieldID, config json.RawMessage) (int, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateAppFieldRawConfig", Args: []interface{}{ctx, appId, appFieldId, config}})
+	m.mu.Unlock()
+
+	if m.UpdateAppFieldRawConfigFunc == nil {
+		panic("podiomock: AppService.UpdateAppFieldRawConfig called without UpdateAppFieldRawConfigFunc")
+	}
+	return m.UpdateAppFieldRawConfigFunc(ctx, appId, appFieldId, config)
+}
+
+func (m *AppService) GetFieldRange(ctx context.Context, fieldID podio.FieldID) (podio.FieldRange, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetFieldRange", Args: []interface{}{ctx, fieldID}})
+	m.mu.Unlock()
+
+	if m.GetFieldRangeFunc == nil {
+		panic("podiomock: AppService.GetFieldRange called without GetFieldRangeFunc")
+	}
+	return m.GetFieldRangeFunc(ctx, fieldID)
+}
+
+func (m *AppService) GetForms(ctx context.Context, appId podio.AppID) (forms []*podio.Form, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetForms", Args: []interface{}{ctx, appId}})
+	m.mu.Unlock()
+
+	if m.GetFormsFunc == nil {
+		panic("podiomock: AppService.GetForms called without GetFormsFunc")
+	}
+	return m.GetFormsFunc(ctx, appId)
+}
+
+// BatchService is a mock of podio.BatchService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type BatchService struct {
+	GetBatchFunc func(ctx context.Context, batchId int64) (batch *podio.Batch, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.BatchService = (*BatchService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *BatchService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *BatchService) GetBatch(ctx context.Context, batchId int64) (batch *podio.Batch, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetBatch", Args: []interface{}{ctx, batchId}})
+	m.mu.Unlock()
+
+	if m.GetBatchFunc == nil {
+		panic("podiomock: BatchService.GetBatch called without GetBatchFunc")
+	}
+	return m.GetBatchFunc(ctx, batchId)
+}
+
+// CommentService is a mock of podio.CommentService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type CommentService struct {
+	CommentFunc       func(ctx context.Context, refType string, refId int64, text string, params map[string]interface{}) (*podio.Comment, error)
+	UpdateCommentFunc func(ctx context.Context, commentID int64, text string, params map[string]interface{}) error
+	DeleteCommentFunc func(ctx context.Context, commentID int64) error
+	GetCommentsFunc   func(ctx context.Context, refType string, refId int64) (comments []*podio.Comment, err error)
+	GetCommentFunc    func(ctx context.Context, commentId int64) (comment *podio.Comment, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.CommentService = (*CommentService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *CommentService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *CommentService) Comment(ctx context.Context, refType string, refId int64, text string, params map[string]interface{}) (*podio.Comment, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Comment", Args: []interface{}{ctx, refType, refId, text, params}})
+	m.mu.Unlock()
+
+	if m.CommentFunc == nil {
+		panic("podiomock: CommentService.Comment called without CommentFunc")
+	}
+	return m.CommentFunc(ctx, refType, refId, text, params)
+}
+
+func (m *CommentService) UpdateComment(ctx context.Context, commentID int64, text string, params map[string]interface{}) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateComment", Args: []interface{}{ctx, commentID, text, params}})
+	m.mu.Unlock()
+
+	if m.UpdateCommentFunc == nil {
+		panic("podiomock: CommentService.UpdateComment called without UpdateCommentFunc")
+	}
+	return m.UpdateCommentFunc(ctx, commentID, text, params)
+}
+
+func (m *CommentService) DeleteComment(ctx context.Context, commentID int64) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteComment", Args: []interface{}{ctx, commentID}})
+	m.mu.Unlock()
+
+	if m.DeleteCommentFunc == nil {
+		panic("podiomock: CommentService.DeleteComment called without DeleteCommentFunc")
+	}
+	return m.DeleteCommentFunc(ctx, commentID)
+}
+
+func (m *CommentService) GetComments(ctx context.Context, refType string, refId int64) (comments []*podio.Comment, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetComments", Args: []interface{}{ctx, refType, refId}})
+	m.mu.Unlock()
+
+	if m.GetCommentsFunc == nil {
+		panic("podiomock: CommentService.GetComments called without GetCommentsFunc")
+	}
+	return m.GetCommentsFunc(ctx, refType, refId)
+}
+
+func (m *CommentService) GetComment(ctx context.Context, commentId int64) (comment *podio.Comment, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetComment", Args: []interface{}{ctx, commentId}})
+	m.mu.Unlock()
+
+	if m.GetCommentFunc == nil {
+		panic("podiomock: CommentService.GetComment called without GetCommentFunc")
+	}
+	return m.GetCommentFunc(ctx, commentId)
+}
+
+// ContactService is a mock of podio.ContactService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type ContactService struct {
+	GetContactsFunc func(ctx context.Context, limit, offset int) (contacts []podio.Contact, err error)
+	GetContactFunc  func(ctx context.Context, userId podio.UserID) (contact podio.Contact, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.ContactService = (*ContactService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *ContactService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *ContactService) GetContacts(ctx context.Context, limit, offset int) (contacts []podio.Contact, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetContacts", Args: []interface{}{ctx, limit, offset}})
+	m.mu.Unlock()
+
+	if m.GetContactsFunc == nil {
+		panic("podiomock: ContactService.GetContacts called without GetContactsFunc")
+	}
+	return m.GetContactsFunc(ctx, limit, offset)
+}
+
+func (m *ContactService) GetContact(ctx context.Context, userId podio.UserID) (contact podio.Contact, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetContact", Args: []interface{}{ctx, userId}})
+	m.mu.Unlock()
+
+	if m.GetContactFunc == nil {
+		panic("podiomock: ContactService.GetContact called without GetContactFunc")
+	}
+	return m.GetContactFunc(ctx, userId)
+}
+
+// ConversationService is a mock of podio.ConversationService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type ConversationService struct {
+	CreateConversationFunc          func(ctx context.Context, params map[string]interface{}) (c podio.Conversation, err error)
+	ConversationAddParticipantsFunc func(ctx context.Context, conversationId int64, participants []interface{}) (err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.ConversationService = (*ConversationService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *ConversationService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *ConversationService) CreateConversation(ctx context.Context, params map[string]interface{}) (c podio.Conversation, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateConversation", Args: []interface{}{ctx, params}})
+	m.mu.Unlock()
+
+	if m.CreateConversationFunc == nil {
+		panic("podiomock: ConversationService.CreateConversation called without CreateConversationFunc")
+	}
+	return m.CreateConversationFunc(ctx, params)
+}
+
+func (m *ConversationService) ConversationAddParticipants(ctx context.Context, conversationId int64, participants []interface{}) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ConversationAddParticipants", Args: []interface{}{ctx, conversationId, participants}})
+	m.mu.Unlock()
+
+	if m.ConversationAddParticipantsFunc == nil {
+		panic("podiomock: ConversationService.ConversationAddParticipants called without ConversationAddParticipantsFunc")
+	}
+	return m.ConversationAddParticipantsFunc(ctx, conversationId, participants)
+}
+
+// EmbedService is a mock of podio.EmbedService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type EmbedService struct {
+	CreateEmbedFunc func(ctx context.Context, params map[string]interface{}) (embed *podio.Embed, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.EmbedService = (*EmbedService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *EmbedService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *EmbedService) CreateEmbed(ctx context.Context, params map[string]interface{}) (embed *podio.Embed, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateEmbed", Args: []interface{}{ctx, params}})
+	m.mu.Unlock()
+
+	if m.CreateEmbedFunc == nil {
+		panic("podiomock: EmbedService.CreateEmbed called without CreateEmbedFunc")
+	}
+	return m.CreateEmbedFunc(ctx, params)
+}
+
+// FileService is a mock of podio.FileService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type FileService struct {
+	GetFilesFunc                  func(ctx context.Context) (files []podio.File, err error)
+	GetFileFunc                   func(ctx context.Context, fileId podio.FileID) (file *podio.File, err error)
+	GetFileContentsFunc           func(ctx context.Context, url string) ([]byte, error)
+	GetFileContentsToTempFileFunc func(ctx context.Context, url string) (tempFilePath, fileName, mimeType string, close func(), err error)
+	FileAndHeadersFunc            func(ctx context.Context, url string) (tempFilePath string, headers map[string]string, close func(), err error)
+	CreateFileFunc                func(ctx context.Context, name string, contents []byte) (file *podio.File, err error)
+	ReplaceFileFunc               func(ctx context.Context, oldFileId, newFileId podio.FileID) error
+	AttachFileFunc                func(ctx context.Context, fileId podio.FileID, refType string, refId int64) error
+	DeleteFileFunc                func(ctx context.Context, fileId podio.FileID) error
+	CopyFileFunc                  func(ctx context.Context, fileId podio.FileID) (podio.FileID, error)
+	FindFilesForSpaceFunc         func(ctx context.Context, spaceId podio.SpaceID, params map[string]interface{}) (files []*podio.File, err error)
+	FindFilesForAppFunc           func(ctx context.Context, appId podio.AppID, params map[string]interface{}) (files []*podio.File, err error)
+	UpdateFileFunc                func(ctx context.Context, fileId podio.FileID, description string) (err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.FileService = (*FileService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *FileService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *FileService) GetFiles(ctx context.Context) (files []podio.File, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetFiles", Args: []interface{}{ctx}})
+	m.mu.Unlock()
+
+	if m.GetFilesFunc == nil {
+		panic("podiomock: FileService.GetFiles called without GetFilesFunc")
+	}
+	return m.GetFilesFunc(ctx)
+}
+
+func (m *FileService) GetFile(ctx context.Context, fileId podio.FileID) (file *podio.File, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetFile", Args: []interface{}{ctx, fileId}})
+	m.mu.Unlock()
+
+	if m.GetFileFunc == nil {
+		panic("podiomock: FileService.GetFile called without GetFileFunc")
+	}
+	return m.GetFileFunc(ctx, fileId)
+}
+
+func (m *FileService) GetFileContents(ctx context.Context, url string) ([]byte, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetFileContents", Args: []interface{}{ctx, url}})
+	m.mu.Unlock()
+
+	if m.GetFileContentsFunc == nil {
+		panic("podiomock: FileService.GetFileContents called without GetFileContentsFunc")
+	}
+	return m.GetFileContentsFunc(ctx, url)
+}
+
+func (m *FileService) GetFileContentsToTempFile(ctx context.Context, url string) (tempFilePath, fileName, mimeType string, close func(), err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetFileContentsToTempFile", Args: []interface{}{ctx, url}})
+	m.mu.Unlock()
+
+	if m.GetFileContentsToTempFileFunc == nil {
+		panic("podiomock: FileService.GetFileContentsToTempFile called without GetFileContentsToTempFileFunc")
+	}
+	return m.GetFileContentsToTempFileFunc(ctx, url)
+}
+
+func (m *FileService) FileAndHeaders(ctx context.Context, url string) (tempFilePath string, headers map[string]string, close func(), err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FileAndHeaders", Args: []interface{}{ctx, url}})
+	m.mu.Unlock()
+
+	if m.FileAndHeadersFunc == nil {
+		panic("podiomock: FileService.FileAndHeaders called without FileAndHeadersFunc")
+	}
+	return m.FileAndHeadersFunc(ctx, url)
+}
+
+func (m *FileService) CreateFile(ctx context.Context, name string, contents []byte) (file *podio.File, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateFile", Args: []interface{}{ctx, name, contents}})
+	m.mu.Unlock()
+
+	if m.CreateFileFunc == nil {
+		panic("podiomock: FileService.CreateFile called without CreateFileFunc")
+	}
+	return m.CreateFileFunc(ctx, name, contents)
+}
+
+func (m *FileService) ReplaceFile(ctx context.Context, oldFileId, newFileId podio.FileID) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ReplaceFile", Args: []interface{}{ctx, oldFileId, newFileId}})
+	m.mu.Unlock()
+
+	if m.ReplaceFileFunc == nil {
+		panic("podiomock: FileService.ReplaceFile called without ReplaceFileFunc")
+	}
+	return m.ReplaceFileFunc(ctx, oldFileId, newFileId)
+}
+
+func (m *FileService) AttachFile(ctx context.Context, fileId podio.FileID, refType string, refId int64) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "AttachFile", Args: []interface{}{ctx, fileId, refType, refId}})
+	m.mu.Unlock()
+
+	if m.AttachFileFunc == nil {
+		panic("podiomock: FileService.AttachFile called without AttachFileFunc")
+	}
+	return m.AttachFileFunc(ctx, fileId, refType, refId)
+}
+
+func (m *FileService) DeleteFile(ctx context.Context, fileId podio.FileID) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteFile", Args: []interface{}{ctx, fileId}})
+	m.mu.Unlock()
+
+	if m.DeleteFileFunc == nil {
+		panic("podiomock: FileService.DeleteFile called without DeleteFileFunc")
+	}
+	return m.DeleteFileFunc(ctx, fileId)
+}
+
+func (m *FileService) CopyFile(ctx context.Context, fileId podio.FileID) (podio.FileID, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CopyFile", Args: []interface{}{ctx, fileId}})
+	m.mu.Unlock()
+
+	if m.CopyFileFunc == nil {
+		panic("podiomock: FileService.CopyFile called without CopyFileFunc")
+	}
+	return m.CopyFileFunc(ctx, fileId)
+}
+
+func (m *FileService) FindFilesForSpace(ctx context.Context, spaceId podio.SpaceID, params map[string]interface{}) (files []*podio.File, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FindFilesForSpace", Args: []interface{}{ctx, spaceId, params}})
+	m.mu.Unlock()
+
+	if m.FindFilesForSpaceFunc == nil {
+		panic("podiomock: FileService.FindFilesForSpace called without FindFilesForSpaceFunc")
+	}
+	return m.FindFilesForSpaceFunc(ctx, spaceId, params)
+}
+
+func (m *FileService) FindFilesForApp(ctx context.Context, appId podio.AppID, params map[string]interface{}) (files []*podio.File, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FindFilesForApp", Args: []interface{}{ctx, appId, params}})
+	m.mu.Unlock()
+
+	if m.FindFilesForAppFunc == nil {
+		panic("podiomock: FileService.FindFilesForApp called without FindFilesForAppFunc")
+	}
+	return m.FindFilesForAppFunc(ctx, appId, params)
+}
+
+func (m *FileService) UpdateFile(ctx context.Context, fileId podio.FileID, description string) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateFile", Args: []interface{}{ctx, fileId, description}})
+	m.mu.Unlock()
+
+	if m.UpdateFileFunc == nil {
+		panic("podiomock: FileService.UpdateFile called without UpdateFileFunc")
+	}
+	return m.UpdateFileFunc(ctx, fileId, description)
+}
+
+// GrantService is a mock of podio.GrantService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type GrantService struct {
+	CreateGrantFunc func(ctx context.Context, refType string, refId int64, params map[string]interface{}) (g podio.GrantResponse, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.GrantService = (*GrantService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *GrantService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *GrantService) CreateGrant(ctx context.Context, refType string, refId int64, params map[string]interface{}) (g podio.GrantResponse, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateGrant", Args: []interface{}{ctx, refType, refId, params}})
+	m.mu.Unlock()
+
+	if m.CreateGrantFunc == nil {
+		panic("podiomock: GrantService.CreateGrant called without CreateGrantFunc")
+	}
+	return m.CreateGrantFunc(ctx, refType, refId, params)
+}
+
+// HookService is a mock of podio.HookService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type HookService struct {
+	CreateHookFunc   func(ctx context.Context, refType string, refId int64, url string, hookType string) (hook podio.Hook, err error)
+	VerifyHookFunc   func(ctx context.Context, hookId int64) error
+	ValidateHookFunc func(ctx context.Context, hookId int64, code string) error
+	DeleteHookFunc   func(ctx context.Context, hookId int64) error
+	FindHooksFunc    func(ctx context.Context, refType string, refId int64) (hooks []podio.Hook, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.HookService = (*HookService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *HookService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *HookService) CreateHook(ctx context.Context, refType string, refId int64, url string, hookType string) (hook podio.Hook, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateHook", Args: []interface{}{ctx, refType, refId, url, hookType}})
+	m.mu.Unlock()
+
+	if m.CreateHookFunc == nil {
+		panic("podiomock: HookService.CreateHook called without CreateHookFunc")
+	}
+	return m.CreateHookFunc(ctx, refType, refId, url, hookType)
+}
+
+func (m *HookService) VerifyHook(ctx context.Context, hookId int64) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "VerifyHook", Args: []interface{}{ctx, hookId}})
+	m.mu.Unlock()
+
+	if m.VerifyHookFunc == nil {
+		panic("podiomock: HookService.VerifyHook called without VerifyHookFunc")
+	}
+	return m.VerifyHookFunc(ctx, hookId)
+}
+
+func (m *HookService) ValidateHook(ctx context.Context, hookId int64, code string) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ValidateHook", Args: []interface{}{ctx, hookId, code}})
+	m.mu.Unlock()
+
+	if m.ValidateHookFunc == nil {
+		panic("podiomock: HookService.ValidateHook called without ValidateHookFunc")
+	}
+	return m.ValidateHookFunc(ctx, hookId, code)
+}
+
+func (m *HookService) DeleteHook(ctx context.Context, hookId int64) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteHook", Args: []interface{}{ctx, hookId}})
+	m.mu.Unlock()
+
+	if m.DeleteHookFunc == nil {
+		panic("podiomock: HookService.DeleteHook called without DeleteHookFunc")
+	}
+	return m.DeleteHookFunc(ctx, hookId)
+}
+
+func (m *HookService) FindHooks(ctx context.Context, refType string, refId int64) (hooks []podio.Hook, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FindHooks", Args: []interface{}{ctx, refType, refId}})
+	m.mu.Unlock()
+
+	if m.FindHooksFunc == nil {
+		panic("podiomock: HookService.FindHooks called without FindHooksFunc")
+	}
+	return m.FindHooksFunc(ctx, refType, refId)
+}
+
+// ItemService is a mock of podio.ItemService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type ItemService struct {
+	GetItemsFunc                          func(ctx context.Context, appId podio.AppID) (items *podio.ItemList, err error)
+	GetItemsSimpleFunc                    func(ctx context.Context, appId podio.AppID) (items *podio.ItemListSimple, err error)
+	FilterItemsFunc                       func(ctx context.Context, appId podio.AppID, params map[string]interface{}) (items *podio.ItemList, err error)
+	FilterItemsSimpleFunc                 func(ctx context.Context, appId podio.AppID, params map[string]interface{}) (items *podio.ItemListSimple, err error)
+	FilterItemsSimpleWithCustomFieldsFunc func(ctx context.Context, appId podio.AppID, params map[string]interface{}, fields string) (items *podio.ItemListSimple, err error)
+	FilterItemsMicroFunc                  func(ctx context.Context, appId podio.AppID, params map[string]interface{}) (items *podio.ItemListMicro, err error)
+	FilterItemsMiniFunc                   func(ctx context.Context, appId podio.AppID, params map[string]interface{}) (items *podio.ItemListMini, err error)
+	ExportItemsFunc                       func(ctx context.Context, appId podio.AppID, exportFormat string, params map[string]interface{}) (int64, error)
+	GetItemByAppItemIdFunc                func(ctx context.Context, appId podio.AppID, formattedAppItemId string) (item *podio.Item, err error)
+	GetItemSimpleByAppItemIdFunc          func(ctx context.Context, appId podio.AppID, formattedAppItemId string) (item *podio.ItemSimple, err error)
+	GetItemByExternalIDFunc               func(ctx context.Context, appId podio.AppID, externalId string) (item *podio.Item, err error)
+	GetItemFunc                           func(ctx context.Context, itemId podio.ItemID) (item *podio.Item, err error)
+	GetItemSimpleFunc                     func(ctx context.Context, itemId podio.ItemID) (item *podio.ItemSimple, err error)
+	GetItemSimpleByExternalIDFunc         func(ctx context.Context, appId podio.AppID, externalId string) (item *podio.ItemSimple, err error)
+	GetItemMicroFunc                      func(ctx context.Context, itemId podio.ItemID) (item *podio.ItemMicro, err error)
+	CreateItemFunc                        func(ctx context.Context, appId podio.AppID, externalId string, fieldValues map[string]interface{}) (podio.ItemID, error)
+	CreateItemThroughParamsFunc           func(ctx context.Context, appId podio.AppID, params, options map[string]interface{}) (item *podio.ItemSimple, err error)
+	UpdateItemFunc                        func(ctx context.Context, itemId podio.ItemID, fieldValues map[string]interface{}) error
+	UpdateItemWithParamsFunc              func(ctx context.Context, itemId podio.ItemID, params, options map[string]interface{}) (err error)
+	ItemCountFunc                         func(ctx context.Context, appId podio.AppID, options map[string]interface{}) (count podio.ItemCount, err error)
+	ItemSearchFieldFunc                   func(ctx context.Context, AppFieldId podio.FieldID, options map[string]interface{}) (items []podio.Item, err error)
+	ItemCloneFunc                         func(ctx context.Context, itemID podio.ItemID, options map[string]interface{}) (clonedItemID podio.ItemID, err error)
+	ItemBulkDeleteFunc                    func(ctx context.Context, appID podio.AppID, params map[string]interface{}) (err error)
+	ItemDeleteFunc                        func(ctx context.Context, itemID podio.ItemID, params map[string]interface{}) (err error)
+	GetItemReferencesFunc                 func(ctx context.Context, itemID podio.ItemID) (references []*podio.ItemReferences, err error)
+	GetItemReferencesByFieldFunc          func(ctx context.Context, itemID podio.ItemID, appFieldID podio.FieldID) (references []*podio.ItemMicro, err error)
+	IterateItemsFunc                      func(ctx context.Context, appId podio.AppID, params map[string]interface{}, opts ...podio.IterateOption) iter.Seq2[*podio.Item, error]
+	IterateItemsSimpleFunc                func(ctx context.Context, appId podio.AppID, params map[string]interface{}, opts ...podio.IterateOption) iter.Seq2[*podio.ItemSimple, error]
+	IterateItemsMicroFunc                 func(ctx context.Context, appId podio.AppID, params map[string]interface{}, opts ...podio.IterateOption) iter.Seq2[*podio.ItemMicro, error]
+	IterateItemsMiniFunc                  func(ctx context.Context, appId podio.AppID, params map[string]interface{}, opts ...podio.IterateOption) iter.Seq2[*podio.ItemMini, error]
+	CloneItemWithValuesFunc               func(ctx context.Context, itemId podio.ItemID, values *podio.ItemValues, options map[string]interface{}) (clonedItemId podio.ItemID, err error)
+	RevertToRevisionFunc                  func(ctx context.Context, ItemId podio.ItemID, revisionId int) (rawResponse *json.RawMessage, err error)
+	RevisionsByItemIdFunc                 func(ctx context.Context, ItemId podio.ItemID) (revisions []podio.ItemRevision, err error)
+	ImporterFunc                          func(ctx context.Context, appId podio.AppID, fileId podio.FileID, params map[string]interface{}) (batchID int64, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.ItemService = (*ItemService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *ItemService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *ItemService) GetItems(ctx context.Context, appId podio.AppID) (items *podio.ItemList, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetItems", Args: []interface{}{ctx, appId}})
+	m.mu.Unlock()
+
+	if m.GetItemsFunc == nil {
+		panic("podiomock: ItemService.GetItems called without GetItemsFunc")
+	}
+	return m.GetItemsFunc(ctx, appId)
+}
+
+func (m *ItemService) GetItemsSimple(ctx context.Context, appId podio.AppID) (items *podio.ItemListSimple, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetItemsSimple", Args: []interface{}{ctx, appId}})
+	m.mu.Unlock()
+
+	if m.GetItemsSimpleFunc == nil {
+		panic("podiomock: ItemService.GetItemsSimple called without GetItemsSimpleFunc")
+	}
+	return m.GetItemsSimpleFunc(ctx, appId)
+}
+
+func (m *ItemService) FilterItems(ctx context.Context, appId podio.AppID, params map[string]interface{}) (items *podio.ItemList, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FilterItems", Args: []interface{}{ctx, appId, params}})
+	m.mu.Unlock()
+
+	if m.FilterItemsFunc == nil {
+		panic("podiomock: ItemService.FilterItems called without FilterItemsFunc")
+	}
+	return m.FilterItemsFunc(ctx, appId, params)
+}
+
+func (m *ItemService) FilterItemsSimple(ctx context.Context, appId podio.AppID, params map[string]interface{}) (items *podio.ItemListSimple, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FilterItemsSimple", Args: []interface{}{ctx, appId, params}})
+	m.mu.Unlock()
+
+	if m.FilterItemsSimpleFunc == nil {
+		panic("podiomock: ItemService.FilterItemsSimple called without FilterItemsSimpleFunc")
+	}
+	return m.FilterItemsSimpleFunc(ctx, appId, params)
+}
+
+func (m *ItemService) FilterItemsSimpleWithCustomFields(ctx context.Context, appId podio.AppID, params map[string]interface{}, fields string) (items *podio.ItemListSimple, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FilterItemsSimpleWithCustomFields", Args: []interface{}{ctx, appId, params, fields}})
+	m.mu.Unlock()
+
+	if m.FilterItemsSimpleWithCustomFieldsFunc == nil {
+		panic("podiomock: ItemService.FilterItemsSimpleWithCustomFields called without FilterItemsSimpleWithCustomFieldsFunc")
+	}
+	return m.FilterItemsSimpleWithCustomFieldsFunc(ctx, appId, params, fields)
+}
+
+func (m *ItemService) FilterItemsMicro(ctx context.Context, appId podio.AppID, params map[string]interface{}) (items *podio.ItemListMicro, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FilterItemsMicro", Args: []interface{}{ctx, appId, params}})
+	m.mu.Unlock()
+
+	if m.FilterItemsMicroFunc == nil {
+		panic("podiomock: ItemService.FilterItemsMicro called without FilterItemsMicroFunc")
+	}
+	return m.FilterItemsMicroFunc(ctx, appId, params)
+}
+
+func (m *ItemService) FilterItemsMini(ctx context.Context, appId podio.AppID, params map[string]interface{}) (items *podio.ItemListMini, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FilterItemsMini", Args: []interface{}{ctx, appId, params}})
+	m.mu.Unlock()
+
+	if m.FilterItemsMiniFunc == nil {
+		panic("podiomock: ItemService.FilterItemsMini called without FilterItemsMiniFunc")
+	}
+	return m.FilterItemsMiniFunc(ctx, appId, params)
+}
+
+func (m *ItemService) ExportItems(ctx context.Context, appId podio.AppID, exportFormat string, params map[string]interface{}) (int64, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ExportItems", Args: []interface{}{ctx, appId, exportFormat, params}})
+	m.mu.Unlock()
+
+	if m.ExportItemsFunc == nil {
+		panic("podiomock: ItemService.ExportItems called without ExportItemsFunc")
+	}
+	return m.ExportItemsFunc(ctx, appId, exportFormat, params)
+}
+
+func (m *ItemService) GetItemByAppItemId(ctx context.Context, appId podio.AppID, formattedAppItemId string) (item *podio.Item, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetItemByAppItemId", Args: []interface{}{ctx, appId, formattedAppItemId}})
+	m.mu.Unlock()
+
+	if m.GetItemByAppItemIdFunc == nil {
+		panic("podiomock: ItemService.GetItemByAppItemId called without GetItemByAppItemIdFunc")
+	}
+	return m.GetItemByAppItemIdFunc(ctx, appId, formattedAppItemId)
+}
+
+func (m *ItemService) GetItemSimpleByAppItemId(ctx context.Context, appId podio.AppID, formattedAppItemId string) (item *podio.ItemSimple, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetItemSimpleByAppItemId", Args: []interface{}{ctx, appId, formattedAppItemId}})
+	m.mu.Unlock()
+
+	if m.GetItemSimpleByAppItemIdFunc == nil {
+		panic("podiomock: ItemService.GetItemSimpleByAppItemId called without GetItemSimpleByAppItemIdFunc")
+	}
+	return m.GetItemSimpleByAppItemIdFunc(ctx, appId, formattedAppItemId)
+}
+
+func (m *ItemService) GetItemByExternalID(ctx context.Context, appId podio.AppID, externalId string) (item *podio.Item, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetItemByExternalID", Args: []interface{}{ctx, appId, externalId}})
+	m.mu.Unlock()
+
+	if m.GetItemByExternalIDFunc == nil {
+		panic("podiomock: ItemService.GetItemByExternalID called without GetItemByExternalIDFunc")
+	}
+	return m.GetItemByExternalIDFunc(ctx, appId, externalId)
+}
+
+func (m *ItemService) GetItem(ctx context.Context, itemId podio.ItemID) (item *podio.Item, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetItem", Args: []interface{}{ctx, itemId}})
+	m.mu.Unlock()
+
+	if m.GetItemFunc == nil {
+		panic("podiomock: ItemService.GetItem called without GetItemFunc")
+	}
+	return m.GetItemFunc(ctx, itemId)
+}
+
+func (m *ItemService) GetItemSimple(ctx context.Context, itemId podio.ItemID) (item *podio.ItemSimple, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetItemSimple", Args: []interface{}{ctx, itemId}})
+	m.mu.Unlock()
+
+	if m.GetItemSimpleFunc == nil {
+		panic("podiomock: ItemService.GetItemSimple called without GetItemSimpleFunc")
+	}
+	return m.GetItemSimpleFunc(ctx, itemId)
+}
+
+func (m *ItemService) GetItemSimpleByExternalID(ctx context.Context, appId podio.AppID, externalId string) (item *podio.ItemSimple, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetItemSimpleByExternalID", Args: []interface{}{ctx, appId, externalId}})
+	m.mu.Unlock()
+
+	if m.GetItemSimpleByExternalIDFunc == nil {
+		panic("podiomock: ItemService.GetItemSimpleByExternalID called without GetItemSimpleByExternalIDFunc")
+	}
+	return m.GetItemSimpleByExternalIDFunc(ctx, appId, externalId)
+}
+
+func (m *ItemService) GetItemMicro(ctx context.Context, itemId podio.ItemID) (item *podio.ItemMicro, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetItemMicro", Args: []interface{}{ctx, itemId}})
+	m.mu.Unlock()
+
+	if m.GetItemMicroFunc == nil {
+		panic("podiomock: ItemService.GetItemMicro called without GetItemMicroFunc")
+	}
+	return m.GetItemMicroFunc(ctx, itemId)
+}
+
+func (m *ItemService) CreateItem(ctx context.Context, appId podio.AppID, externalId string, fieldValues map[string]interface{}) (podio.ItemID, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateItem", Args: []interface{}{ctx, appId, externalId, fieldValues}})
+	m.mu.Unlock()
+
+	if m.CreateItemFunc == nil {
+		panic("podiomock: ItemService.CreateItem called without CreateItemFunc")
+	}
+	return m.CreateItemFunc(ctx, appId, externalId, fieldValues)
+}
+
+func (m *ItemService) CreateItemThroughParams(ctx context.Context, appId podio.AppID, params, options map[string]interface{}) (item *podio.ItemSimple, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateItemThroughParams", Args: []interface{}{ctx, appId, params, options}})
+	m.mu.Unlock()
+
+	if m.CreateItemThroughParamsFunc == nil {
+		panic("podiomock: ItemService.CreateItemThroughParams called without CreateItemThroughParamsFunc")
+	}
+	return m.CreateItemThroughParamsFunc(ctx, appId, params, options)
+}
+
+func (m *ItemService) UpdateItem(ctx context.Context, itemId podio.ItemID, fieldValues map[string]interface{}) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateItem", Args: []interface{}{ctx, itemId, fieldValues}})
+	m.mu.Unlock()
+
+	if m.UpdateItemFunc == nil {
+		panic("podiomock: ItemService.UpdateItem called without UpdateItemFunc")
+	}
+	return m.UpdateItemFunc(ctx, itemId, fieldValues)
+}
+
+func (m *ItemService) UpdateItemWithParams(ctx context.Context, itemId podio.ItemID, params, options map[string]interface{}) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateItemWithParams", Args: []interface{}{ctx, itemId, params, options}})
+	m.mu.Unlock()
+
+	if m.UpdateItemWithParamsFunc == nil {
+		panic("podiomock: ItemService.UpdateItemWithParams called without UpdateItemWithParamsFunc")
+	}
+	return m.UpdateItemWithParamsFunc(ctx, itemId, params, options)
+}
+
+func (m *ItemService) ItemCount(ctx context.Context, appId podio.AppID, options map[string]interface{}) (count podio.ItemCount, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ItemCount", Args: []interface{}{ctx, appId, options}})
+	m.mu.Unlock()
+
+	if m.ItemCountFunc == nil {
+		panic("podiomock: ItemService.ItemCount called without ItemCountFunc")
+	}
+	return m.ItemCountFunc(ctx, appId, options)
+}
+
+func (m *ItemService) ItemSearchField(ctx context.Context, AppFieldId podio.FieldID, options map[string]interface{}) (items []podio.Item, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ItemSearchField", Args: []interface{}{ctx, AppFieldId, options}})
+	m.mu.Unlock()
+
+	if m.ItemSearchFieldFunc == nil {
+		panic("podiomock: ItemService.ItemSearchField called without ItemSearchFieldFunc")
+	}
+	return m.ItemSearchFieldFunc(ctx, AppFieldId, options)
+}
+
+func (m *ItemService) ItemClone(ctx context.Context, itemID podio.ItemID, options map[string]interface{}) (clonedItemID podio.ItemID, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ItemClone", Args: []interface{}{ctx, itemID, options}})
+	m.mu.Unlock()
+
+	if m.ItemCloneFunc == nil {
+		panic("podiomock: ItemService.ItemClone called without ItemCloneFunc")
+	}
+	return m.ItemCloneFunc(ctx, itemID, options)
+}
+
+func (m *ItemService) ItemBulkDelete(ctx context.Context, appID podio.AppID, params map[string]interface{}) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ItemBulkDelete", Args: []interface{}{ctx, appID, params}})
+	m.mu.Unlock()
+
+	if m.ItemBulkDeleteFunc == nil {
+		panic("podiomock: ItemService.ItemBulkDelete called without ItemBulkDeleteFunc")
+	}
+	return m.ItemBulkDeleteFunc(ctx, appID, params)
+}
+
+func (m *ItemService) ItemDelete(ctx context.Context, itemID podio.ItemID, params map[string]interface{}) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ItemDelete", Args: []interface{}{ctx, itemID, params}})
+	m.mu.Unlock()
+
+	if m.ItemDeleteFunc == nil {
+		panic("podiomock: ItemService.ItemDelete called without ItemDeleteFunc")
+	}
+	return m.ItemDeleteFunc(ctx, itemID, params)
+}
+
+func (m *ItemService) GetItemReferences(ctx context.Context, itemID podio.ItemID) (references []*podio.ItemReferences, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetItemReferences", Args: []interface{}{ctx, itemID}})
+	m.mu.Unlock()
+
+	if m.GetItemReferencesFunc == nil {
+		panic("podiomock: ItemService.GetItemReferences called without GetItemReferencesFunc")
+	}
+	return m.GetItemReferencesFunc(ctx, itemID)
+}
+
+func (m *ItemService) GetItemReferencesByField(ctx context.Context, itemID podio.ItemID, appFieldID podio.FieldID) (references []*podio.ItemMicro, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetItemReferencesByField", Args: []interface{}{ctx, itemID, appFieldID}})
+	m.mu.Unlock()
+
+	if m.GetItemReferencesByFieldFunc == nil {
+		panic("podiomock: ItemService.GetItemReferencesByField called without GetItemReferencesByFieldFunc")
+	}
+	return m.GetItemReferencesByFieldFunc(ctx, itemID, appFieldID)
+}
+
+func (m *ItemService) IterateItems(ctx context.Context, appId podio.AppID, params map[string]interface{}, opts ...podio.IterateOption) iter.Seq2[*podio.Item, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "IterateItems", Args: []interface{}{ctx, appId, params, opts}})
+	m.mu.Unlock()
+
+	if m.IterateItemsFunc == nil {
+		panic("podiomock: ItemService.IterateItems called without IterateItemsFunc")
+	}
+	return m.IterateItemsFunc(ctx, appId, params, opts...)
+}
+
+func (m *ItemService) IterateItemsSimple(ctx context.Context, appId podio.AppID, params map[string]interface{}, opts ...podio.IterateOption) iter.Seq2[*podio.ItemSimple, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "IterateItemsSimple", Args: []interface{}{ctx, appId, params, opts}})
+	m.mu.Unlock()
+
+	if m.IterateItemsSimpleFunc == nil {
+		panic("podiomock: ItemService.IterateItemsSimple called without IterateItemsSimpleFunc")
+	}
+	return m.IterateItemsSimpleFunc(ctx, appId, params, opts...)
+}
+
+func (m *ItemService) IterateItemsMicro(ctx context.Context, appId podio.AppID, params map[string]interface{}, opts ...podio.IterateOption) iter.Seq2[*podio.ItemMicro, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "IterateItemsMicro", Args: []interface{}{ctx, appId, params, opts}})
+	m.mu.Unlock()
+
+	if m.IterateItemsMicroFunc == nil {
+		panic("podiomock: ItemService.IterateItemsMicro called without IterateItemsMicroFunc")
+	}
+	return m.IterateItemsMicroFunc(ctx, appId, params, opts...)
+}
+
+func (m *ItemService) IterateItemsMini(ctx context.Context, appId podio.AppID, params map[string]interface{}, opts ...podio.IterateOption) iter.Seq2[*podio.ItemMini, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "IterateItemsMini", Args: []interface{}{ctx, appId, params, opts}})
+	m.mu.Unlock()
+
+	if m.IterateItemsMiniFunc == nil {
+		panic("podiomock: ItemService.IterateItemsMini called without IterateItemsMiniFunc")
+	}
+	return m.IterateItemsMiniFunc(ctx, appId, params, opts...)
+}
+
+func (m *ItemService) CloneItemWithValues(ctx context.Context, itemId podio.ItemID, values *podio.ItemValues, options map[string]interface{}) (clonedItemId podio.ItemID, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CloneItemWithValues", Args: []interface{}{ctx, itemId, values, options}})
+	m.mu.Unlock()
+
+	if m.CloneItemWithValuesFunc == nil {
+		panic("podiomock: ItemService.CloneItemWithValues called without CloneItemWithValuesFunc")
+	}
+	return m.CloneItemWithValuesFunc(ctx, itemId, values, options)
+}
+
+func (m *ItemService) RevertToRevision(ctx context.Context, ItemId podio.ItemID, revisionId int) (rawResponse *json.RawMessage, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "RevertToRevision", Args: []interface{}{ctx, ItemId, revisionId}})
+	m.mu.Unlock()
+
+	if m.RevertToRevisionFunc == nil {
+		panic("podiomock: ItemService.RevertToRevision called without RevertToRevisionFunc")
+	}
+	return m.RevertToRevisionFunc(ctx, ItemId, revisionId)
+}
+
+func (m *ItemService) RevisionsByItemId(ctx context.Context, ItemId podio.ItemID) (revisions []podio.ItemRevision, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "RevisionsByItemId", Args: []interface{}{ctx, ItemId}})
+	m.mu.Unlock()
+
+	if m.RevisionsByItemIdFunc == nil {
+		panic("podiomock: ItemService.RevisionsByItemId called without RevisionsByItemIdFunc")
+	}
+	return m.RevisionsByItemIdFunc(ctx, ItemId)
+}
+
+func (m *ItemService) Importer(ctx context.Context, appId podio.AppID, fileId podio.FileID, params map[string]interface{}) (batchID int64, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Importer", Args: []interface{}{ctx, appId, fileId, params}})
+	m.mu.Unlock()
+
+	if m.ImporterFunc == nil {
+		panic("podiomock: ItemService.Importer called without ImporterFunc")
+	}
+	return m.ImporterFunc(ctx, appId, fileId, params)
+}
+
+// NotificationService is a mock of podio.NotificationService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type NotificationService struct {
+	NotificationMarkAsViewedForRefFunc func(ctx context.Context, refType string, refId int64) (statusCode int, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.NotificationService = (*NotificationService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *NotificationService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *NotificationService) NotificationMarkAsViewedForRef(ctx context.Context, refType string, refId int64) (statusCode int, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "NotificationMarkAsViewedForRef", Args: []interface{}{ctx, refType, refId}})
+	m.mu.Unlock()
+
+	if m.NotificationMarkAsViewedForRefFunc == nil {
+		panic("podiomock: NotificationService.NotificationMarkAsViewedForRef called without NotificationMarkAsViewedForRefFunc")
+	}
+	return m.NotificationMarkAsViewedForRefFunc(ctx, refType, refId)
+}
+
+// OrgService is a mock of podio.OrgService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type OrgService struct {
+	GetOrganizationsFunc      func(ctx context.Context) (orgs []podio.Organization, err error)
+	GetOrganizationFunc       func(ctx context.Context, id int64) (org *podio.Organization, err error)
+	GetOrganizationBySlugFunc func(ctx context.Context, slug string) (org *podio.Organization, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.OrgService = (*OrgService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *OrgService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *OrgService) GetOrganizations(ctx context.Context) (orgs []podio.Organization, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetOrganizations", Args: []interface{}{ctx}})
+	m.mu.Unlock()
+
+	if m.GetOrganizationsFunc == nil {
+		panic("podiomock: OrgService.GetOrganizations called without GetOrganizationsFunc")
+	}
+	return m.GetOrganizationsFunc(ctx)
+}
+
+func (m *OrgService) GetOrganization(ctx context.Context, id int64) (org *podio.Organization, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetOrganization", Args: []interface{}{ctx, id}})
+	m.mu.Unlock()
+
+	if m.GetOrganizationFunc == nil {
+		panic("podiomock: OrgService.GetOrganization called without GetOrganizationFunc")
+	}
+	return m.GetOrganizationFunc(ctx, id)
+}
+
+func (m *OrgService) GetOrganizationBySlug(ctx context.Context, slug string) (org *podio.Organization, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetOrganizationBySlug", Args: []interface{}{ctx, slug}})
+	m.mu.Unlock()
+
+	if m.GetOrganizationBySlugFunc == nil {
+		panic("podiomock: OrgService.GetOrganizationBySlug called without GetOrganizationBySlugFunc")
+	}
+	return m.GetOrganizationBySlugFunc(ctx, slug)
+}
+
+// SpaceService is a mock of podio.SpaceService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type SpaceService struct {
+	GetSpacesFunc              func(ctx context.Context, orgId int64) (spaces []podio.Space, err error)
+	GetSpaceFunc               func(ctx context.Context, id podio.SpaceID) (space *podio.Space, err error)
+	GetSpaceByOrgIdAndSlugFunc func(ctx context.Context, orgId int64, slug string) (space *podio.Space, err error)
+	CreateSpaceFunc            func(ctx context.Context, orgId int64, name string) (spaceId podio.SpaceID, spaceUrl string, err error)
+	UpdateSpaceFunc            func(ctx context.Context, spaceId podio.SpaceID, name string) (err error)
+	UpdateSpaceUrlLabelFunc    func(ctx context.Context, spaceId podio.SpaceID, urlLabel string) (err error)
+	FindAllForSpaceFunc        func(ctx context.Context, id podio.SpaceID, options map[string]interface{}) (spaceMembers []podio.SpaceMember, err error)
+	FindAllForSpaceV1Func      func(ctx context.Context, id int64, options map[string]interface{}) (spaceMembers []podio.SpaceMemberV1, err error)
+	AddMemberFunc              func(ctx context.Context, id podio.SpaceID, params map[string]interface{}) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.SpaceService = (*SpaceService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *SpaceService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *SpaceService) GetSpaces(ctx context.Context, orgId int64) (spaces []podio.Space, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetSpaces", Args: []interface{}{ctx, orgId}})
+	m.mu.Unlock()
+
+	if m.GetSpacesFunc == nil {
+		panic("podiomock: SpaceService.GetSpaces called without GetSpacesFunc")
+	}
+	return m.GetSpacesFunc(ctx, orgId)
+}
+
+func (m *SpaceService) GetSpace(ctx context.Context, id podio.SpaceID) (space *podio.Space, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetSpace", Args: []interface{}{ctx, id}})
+	m.mu.Unlock()
+
+	if m.GetSpaceFunc == nil {
+		panic("podiomock: SpaceService.GetSpace called without GetSpaceFunc")
+	}
+	return m.GetSpaceFunc(ctx, id)
+}
+
+func (m *SpaceService) GetSpaceByOrgIdAndSlug(ctx context.Context, orgId int64, slug string) (space *podio.Space, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetSpaceByOrgIdAndSlug", Args: []interface{}{ctx, orgId, slug}})
+	m.mu.Unlock()
+
+	if m.GetSpaceByOrgIdAndSlugFunc == nil {
+		panic("podiomock: SpaceService.GetSpaceByOrgIdAndSlug called without GetSpaceByOrgIdAndSlugFunc")
+	}
+	return m.GetSpaceByOrgIdAndSlugFunc(ctx, orgId, slug)
+}
+
+func (m *SpaceService) CreateSpace(ctx context.Context, orgId int64, name string) (spaceId podio.SpaceID, spaceUrl string, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateSpace", Args: []interface{}{ctx, orgId, name}})
+	m.mu.Unlock()
+
+	if m.CreateSpaceFunc == nil {
+		panic("podiomock: SpaceService.CreateSpace called without CreateSpaceFunc")
+	}
+	return m.CreateSpaceFunc(ctx, orgId, name)
+}
+
+func (m *SpaceService) UpdateSpace(ctx context.Context, spaceId podio.SpaceID, name string) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateSpace", Args: []interface{}{ctx, spaceId, name}})
+	m.mu.Unlock()
+
+	if m.UpdateSpaceFunc == nil {
+		panic("podiomock: SpaceService.UpdateSpace called without UpdateSpaceFunc")
+	}
+	return m.UpdateSpaceFunc(ctx, spaceId, name)
+}
+
+func (m *SpaceService) UpdateSpaceUrlLabel(ctx context.Context, spaceId podio.SpaceID, urlLabel string) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateSpaceUrlLabel", Args: []interface{}{ctx, spaceId, urlLabel}})
+	m.mu.Unlock()
+
+	if m.UpdateSpaceUrlLabelFunc == nil {
+		panic("podiomock: SpaceService.UpdateSpaceUrlLabel called without UpdateSpaceUrlLabelFunc")
+	}
+	return m.UpdateSpaceUrlLabelFunc(ctx, spaceId, urlLabel)
+}
+
+func (m *SpaceService) FindAllForSpace(ctx context.Context, id podio.SpaceID, options map[string]interface{}) (spaceMembers []podio.SpaceMember, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FindAllForSpace", Args: []interface{}{ctx, id, options}})
+	m.mu.Unlock()
+
+	if m.FindAllForSpaceFunc == nil {
+		panic("podiomock: SpaceService.FindAllForSpace called without FindAllForSpaceFunc")
+	}
+	return m.FindAllForSpaceFunc(ctx, id, options)
+}
+
+func (m *SpaceService) FindAllForSpaceV1(ctx context.Context, id int64, options map[string]interface{}) (spaceMembers []podio.SpaceMemberV1, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FindAllForSpaceV1", Args: []interface{}{ctx, id, options}})
+	m.mu.Unlock()
+
+	if m.FindAllForSpaceV1Func == nil {
+		panic("podiomock: SpaceService.FindAllForSpaceV1 called without FindAllForSpaceV1Func")
+	}
+	return m.FindAllForSpaceV1Func(ctx, id, options)
+}
+
+func (m *SpaceService) AddMember(ctx context.Context, id podio.SpaceID, params map[string]interface{}) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "AddMember", Args: []interface{}{ctx, id, params}})
+	m.mu.Unlock()
+
+	if m.AddMemberFunc == nil {
+		panic("podiomock: SpaceService.AddMember called without AddMemberFunc")
+	}
+	return m.AddMemberFunc(ctx, id, params)
+}
+
+// StatusService is a mock of podio.StatusService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type StatusService struct {
+	StatusCreateFunc func(ctx context.Context, spaceId podio.SpaceID, params map[string]interface{}) (s podio.Status, err error)
+	StatusUpdateFunc func(ctx context.Context, statusID int64, params map[string]interface{}) error
+	StatusDeleteFunc func(ctx context.Context, statusID int64) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.StatusService = (*StatusService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *StatusService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *StatusService) StatusCreate(ctx context.Context, spaceId podio.SpaceID, params map[string]interface{}) (s podio.Status, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "StatusCreate", Args: []interface{}{ctx, spaceId, params}})
+	m.mu.Unlock()
+
+	if m.StatusCreateFunc == nil {
+		panic("podiomock: StatusService.StatusCreate called without StatusCreateFunc")
+	}
+	return m.StatusCreateFunc(ctx, spaceId, params)
+}
+
+func (m *StatusService) StatusUpdate(ctx context.Context, statusID int64, params map[string]interface{}) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "StatusUpdate", Args: []interface{}{ctx, statusID, params}})
+	m.mu.Unlock()
+
+	if m.StatusUpdateFunc == nil {
+		panic("podiomock: StatusService.StatusUpdate called without StatusUpdateFunc")
+	}
+	return m.StatusUpdateFunc(ctx, statusID, params)
+}
+
+func (m *StatusService) StatusDelete(ctx context.Context, statusID int64) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "StatusDelete", Args: []interface{}{ctx, statusID}})
+	m.mu.Unlock()
+
+	if m.StatusDeleteFunc == nil {
+		panic("podiomock: StatusService.StatusDelete called without StatusDeleteFunc")
+	}
+	return m.StatusDeleteFunc(ctx, statusID)
+}
+
+// StreamService is a mock of podio.StreamService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type StreamService struct {
+	StreamForSpaceV3Func         func(ctx context.Context, spaceId podio.SpaceID, params map[string]interface{}) (s []podio.Stream, err error)
+	StreamForAppV3ReferencesFunc func(ctx context.Context, appId podio.AppID, params map[string]interface{}) (s []podio.StreamReference, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.StreamService = (*StreamService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *StreamService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *StreamService) StreamForSpaceV3(ctx context.Context, spaceId podio.SpaceID, params map[string]interface{}) (s []podio.Stream, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "StreamForSpaceV3", Args: []interface{}{ctx, spaceId, params}})
+	m.mu.Unlock()
+
+	if m.StreamForSpaceV3Func == nil {
+		panic("podiomock: StreamService.StreamForSpaceV3 called without StreamForSpaceV3Func")
+	}
+	return m.StreamForSpaceV3Func(ctx, spaceId, params)
+}
+
+func (m *StreamService) StreamForAppV3References(ctx context.Context, appId podio.AppID, params map[string]interface{}) (s []podio.StreamReference, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "StreamForAppV3References", Args: []interface{}{ctx, appId, params}})
+	m.mu.Unlock()
+
+	if m.StreamForAppV3ReferencesFunc == nil {
+		panic("podiomock: StreamService.StreamForAppV3References called without StreamForAppV3ReferencesFunc")
+	}
+	return m.StreamForAppV3ReferencesFunc(ctx, appId, params)
+}
+
+// SubscriptionService is a mock of podio.SubscriptionService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type SubscriptionService struct {
+	DeleteSubscriptionFunc func(ctx context.Context, refType string, refId int64) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.SubscriptionService = (*SubscriptionService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *SubscriptionService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *SubscriptionService) DeleteSubscription(ctx context.Context, refType string, refId int64) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteSubscription", Args: []interface{}{ctx, refType, refId}})
+	m.mu.Unlock()
+
+	if m.DeleteSubscriptionFunc == nil {
+		panic("podiomock: SubscriptionService.DeleteSubscription called without DeleteSubscriptionFunc")
+	}
+	return m.DeleteSubscriptionFunc(ctx, refType, refId)
+}
+
+// TagService is a mock of podio.TagService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type TagService struct {
+	CreateTagsFunc          func(ctx context.Context, refType string, refId int64, tags []string) (err error)
+	UpdateTagsFunc          func(ctx context.Context, refType string, refId int64, tags []string) (err error)
+	ListTopTagsForApp2Func  func(ctx context.Context, appId podio.AppID, query string, limit int) (tags []string, err error)
+	ListTagsForAppFunc      func(ctx context.Context, appId podio.AppID, query string, limit int) (tags []*podio.Tag, err error)
+	ObjectsOnAppWithTagFunc func(ctx context.Context, appId podio.AppID, tag string) (tags []*podio.TaggedObject, err error)
+	DeleteTagFunc           func(ctx context.Context, refType string, refId int64, text string) (err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.TagService = (*TagService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *TagService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *TagService) CreateTags(ctx context.Context, refType string, refId int64, tags []string) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateTags", Args: []interface{}{ctx, refType, refId, tags}})
+	m.mu.Unlock()
+
+	if m.CreateTagsFunc == nil {
+		panic("podiomock: TagService.CreateTags called without CreateTagsFunc")
+	}
+	return m.CreateTagsFunc(ctx, refType, refId, tags)
+}
+
+func (m *TagService) UpdateTags(ctx context.Context, refType string, refId int64, tags []string) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateTags", Args: []interface{}{ctx, refType, refId, tags}})
+	m.mu.Unlock()
+
+	if m.UpdateTagsFunc == nil {
+		panic("podiomock: TagService.UpdateTags called without UpdateTagsFunc")
+	}
+	return m.UpdateTagsFunc(ctx, refType, refId, tags)
+}
+
+func (m *TagService) ListTopTagsForApp2(ctx context.Context, appId podio.AppID, query string, limit int) (tags []string, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListTopTagsForApp2", Args: []interface{}{ctx, appId, query, limit}})
+	m.mu.Unlock()
+
+	if m.ListTopTagsForApp2Func == nil {
+		panic("podiomock: TagService.ListTopTagsForApp2 called without ListTopTagsForApp2Func")
+	}
+	return m.ListTopTagsForApp2Func(ctx, appId, query, limit)
+}
+
+func (m *TagService) ListTagsForApp(ctx context.Context, appId podio.AppID, query string, limit int) (tags []*podio.Tag, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListTagsForApp", Args: []interface{}{ctx, appId, query, limit}})
+	m.mu.Unlock()
+
+	if m.ListTagsForAppFunc == nil {
+		panic("podiomock: TagService.ListTagsForApp called without ListTagsForAppFunc")
+	}
+	return m.ListTagsForAppFunc(ctx, appId, query, limit)
+}
+
+func (m *TagService) ObjectsOnAppWithTag(ctx context.Context, appId podio.AppID, tag string) (tags []*podio.TaggedObject, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ObjectsOnAppWithTag", Args: []interface{}{ctx, appId, tag}})
+	m.mu.Unlock()
+
+	if m.ObjectsOnAppWithTagFunc == nil {
+		panic("podiomock: TagService.ObjectsOnAppWithTag called without ObjectsOnAppWithTagFunc")
+	}
+	return m.ObjectsOnAppWithTagFunc(ctx, appId, tag)
+}
+
+func (m *TagService) DeleteTag(ctx context.Context, refType string, refId int64, text string) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteTag", Args: []interface{}{ctx, refType, refId, text}})
+	m.mu.Unlock()
+
+	if m.DeleteTagFunc == nil {
+		panic("podiomock: TagService.DeleteTag called without DeleteTagFunc")
+	}
+	return m.DeleteTagFunc(ctx, refType, refId, text)
+}
+
+// TaskService is a mock of podio.TaskService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type TaskService struct {
+	GetTaskFunc      func(ctx context.Context, taskID int64) (task podio.Task, err error)
+	GetTasksFunc     func(ctx context.Context, params map[string]interface{}) (tasks []podio.Task, err error)
+	GetTaskCountFunc func(ctx context.Context, refType string, refId int64) (count podio.TaskCount, err error)
+	CreateTaskFunc   func(ctx context.Context, appId podio.AppID, params, options map[string]interface{}) (task *podio.Task, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.TaskService = (*TaskService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *TaskService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *TaskService) GetTask(ctx context.Context, taskID int64) (task podio.Task, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetTask", Args: []interface{}{ctx, taskID}})
+	m.mu.Unlock()
+
+	if m.GetTaskFunc == nil {
+		panic("podiomock: TaskService.GetTask called without GetTaskFunc")
+	}
+	return m.GetTaskFunc(ctx, taskID)
+}
+
+func (m *TaskService) GetTasks(ctx context.Context, params map[string]interface{}) (tasks []podio.Task, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetTasks", Args: []interface{}{ctx, params}})
+	m.mu.Unlock()
+
+	if m.GetTasksFunc == nil {
+		panic("podiomock: TaskService.GetTasks called without GetTasksFunc")
+	}
+	return m.GetTasksFunc(ctx, params)
+}
+
+func (m *TaskService) GetTaskCount(ctx context.Context, refType string, refId int64) (count podio.TaskCount, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetTaskCount", Args: []interface{}{ctx, refType, refId}})
+	m.mu.Unlock()
+
+	if m.GetTaskCountFunc == nil {
+		panic("podiomock: TaskService.GetTaskCount called without GetTaskCountFunc")
+	}
+	return m.GetTaskCountFunc(ctx, refType, refId)
+}
+
+func (m *TaskService) CreateTask(ctx context.Context, appId podio.AppID, params, options map[string]interface{}) (task *podio.Task, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateTask", Args: []interface{}{ctx, appId, params, options}})
+	m.mu.Unlock()
+
+	if m.CreateTaskFunc == nil {
+		panic("podiomock: TaskService.CreateTask called without CreateTaskFunc")
+	}
+	return m.CreateTaskFunc(ctx, appId, params, options)
+}
+
+// UserService is a mock of podio.UserService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type UserService struct {
+	GetUserFunc       func(ctx context.Context) (user podio.User, err error)
+	GetUserStatusFunc func(ctx context.Context) (user podio.UserStatus, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.UserService = (*UserService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *UserService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *UserService) GetUser(ctx context.Context) (user podio.User, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetUser", Args: []interface{}{ctx}})
+	m.mu.Unlock()
+
+	if m.GetUserFunc == nil {
+		panic("podiomock: UserService.GetUser called without GetUserFunc")
+	}
+	return m.GetUserFunc(ctx)
+}
+
+func (m *UserService) GetUserStatus(ctx context.Context) (user podio.UserStatus, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetUserStatus", Args: []interface{}{ctx}})
+	m.mu.Unlock()
+
+	if m.GetUserStatusFunc == nil {
+		panic("podiomock: UserService.GetUserStatus called without GetUserStatusFunc")
+	}
+	return m.GetUserStatusFunc(ctx)
+}
+
+// ViewService is a mock of podio.ViewService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type ViewService struct {
+	GetViewFunc              func(ctx context.Context, appID podio.AppID, viewIdOrName interface{}) (v podio.View, err error)
+	GetViewsFunc             func(ctx context.Context, appID podio.AppID) (v []podio.ViewFromList, err error)
+	CreateViewWithParamsFunc func(ctx context.Context, appID podio.AppID, params, options map[string]interface{}) (id int64, err error)
+	UpdateViewWithParamsFunc func(ctx context.Context, viewID int64, params map[string]interface{}) (err error)
+	DeleteViewFunc           func(ctx context.Context, viewID int64) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.ViewService = (*ViewService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *ViewService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *ViewService) GetView(ctx context.Context, appID podio.AppID, viewIdOrName interface{}) (v podio.View, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetView", Args: []interface{}{ctx, appID, viewIdOrName}})
+	m.mu.Unlock()
+
+	if m.GetViewFunc == nil {
+		panic("podiomock: ViewService.GetView called without GetViewFunc")
+	}
+	return m.GetViewFunc(ctx, appID, viewIdOrName)
+}
+
+func (m *ViewService) GetViews(ctx context.Context, appID podio.AppID) (v []podio.ViewFromList, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetViews", Args: []interface{}{ctx, appID}})
+	m.mu.Unlock()
+
+	if m.GetViewsFunc == nil {
+		panic("podiomock: ViewService.GetViews called without GetViewsFunc")
+	}
+	return m.GetViewsFunc(ctx, appID)
+}
+
+func (m *ViewService) CreateViewWithParams(ctx context.Context, appID podio.AppID, params, options map[string]interface{}) (id int64, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateViewWithParams", Args: []interface{}{ctx, appID, params, options}})
+	m.mu.Unlock()
+
+	if m.CreateViewWithParamsFunc == nil {
+		panic("podiomock: ViewService.CreateViewWithParams called without CreateViewWithParamsFunc")
+	}
+	return m.CreateViewWithParamsFunc(ctx, appID, params, options)
+}
+
+func (m *ViewService) UpdateViewWithParams(ctx context.Context, viewID int64, params map[string]interface{}) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateViewWithParams", Args: []interface{}{ctx, viewID, params}})
+	m.mu.Unlock()
+
+	if m.UpdateViewWithParamsFunc == nil {
+		panic("podiomock: ViewService.UpdateViewWithParams called without UpdateViewWithParamsFunc")
+	}
+	return m.UpdateViewWithParamsFunc(ctx, viewID, params)
+}
+
+func (m *ViewService) DeleteView(ctx context.Context, viewID int64) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteView", Args: []interface{}{ctx, viewID}})
+	m.mu.Unlock()
+
+	if m.DeleteViewFunc == nil {
+		panic("podiomock: ViewService.DeleteView called without DeleteViewFunc")
+	}
+	return m.DeleteViewFunc(ctx, viewID)
+}
+
+// WidgetService is a mock of podio.WidgetService. Set the func of every method the code under test
+// calls, calling a method without a func panics.
+type WidgetService struct {
+	GetWidgetFunc    func(ctx context.Context, widgetID int64) (w podio.Widget, err error)
+	GetWidgetsFunc   func(ctx context.Context, refType string, refID int64) (w []podio.Widget, err error)
+	DeleteWidgetFunc func(ctx context.Context, widgetID int64) (err error)
+	CreateWidgetFunc func(ctx context.Context, refType string, refID int64, params map[string]interface{}) (id int64, err error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ podio.WidgetService = (*WidgetService)(nil)
+
+// Calls returns the calls made so far in order
+func (m *WidgetService) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+func (m *WidgetService) GetWidget(ctx context.Context, widgetID int64) (w podio.Widget, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetWidget", Args: []interface{}{ctx, widgetID}})
+	m.mu.Unlock()
+
+	if m.GetWidgetFunc == nil {
+		panic("podiomock: WidgetService.GetWidget called without GetWidgetFunc")
+	}
+	return m.GetWidgetFunc(ctx, widgetID)
+}
+
+func (m *WidgetService) GetWidgets(ctx context.Context, refType string, refID int64) (w []podio.Widget, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetWidgets", Args: []interface{}{ctx, refType, refID}})
+	m.mu.Unlock()
+
+	if m.GetWidgetsFunc == nil {
+		panic("podiomock: WidgetService.GetWidgets called without GetWidgetsFunc")
+	}
+	return m.GetWidgetsFunc(ctx, refType, refID)
+}
+
+func (m *WidgetService) DeleteWidget(ctx context.Context, widgetID int64) (err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteWidget", Args: []interface{}{ctx, widgetID}})
+	m.mu.Unlock()
+
+	if m.DeleteWidgetFunc == nil {
+		panic("podiomock: WidgetService.DeleteWidget called without DeleteWidgetFunc")
+	}
+	return m.DeleteWidgetFunc(ctx, widgetID)
+}
+
+func (m *WidgetService) CreateWidget(ctx context.Context, refType string, refID int64, params map[string]interface{}) (id int64, err error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateWidget", Args: []interface{}{ctx, refType, refID, params}})
+	m.mu.Unlock()
+
+	if m.CreateWidgetFunc == nil {
+		panic("podiomock: WidgetService.CreateWidget called without CreateWidgetFunc")
+	}
+	return m.CreateWidgetFunc(ctx, refType, refID, params)
+}
@@ -0,0 +1,18 @@
+// Package podiomock has mocks of the podio service interfaces:
+//
+//	items := &podiomock.ItemService{
+//		GetItemFunc: func(ctx context.Context, itemId podio.ItemID) (*podio.Item, error) {
+//			return &podio.Item{Id: itemId, Title: "Launch"}, nil
+//		},
+//	}
+//	err := archive(ctx, items, 42) // code that accepts a podio.ItemService
+//	calls := items.Calls()
+package podiomock
+
+//go:generate go run ../internal/mockgen -source ../services.go -out mocks.go
+
+// Call is a call made to a mock
+type Call struct {
+	Method string
+	Args   []interface{}
+}
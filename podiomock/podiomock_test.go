@@ -0,0 +1,32 @@
+package podiomock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andreas/podio-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemServiceMock(t *testing.T) {
+	r := require.New(t)
+
+	items := &ItemService{
+		GetItemFunc: func(ctx context.Context, itemId podio.ItemID) (*podio.Item, error) {
+			return &podio.Item{Id: itemId, Title: "Launch"}, nil
+		},
+	}
+	var service podio.ItemService = items
+
+	item, err := service.GetItem(context.Background(), 42)
+	r.NoError(err)
+	r.Equal("Launch", item.Title)
+	r.Len(items.Calls(), 1)
+	r.Equal("GetItem", items.Calls()[0].Method)
+	r.Equal(podio.ItemID(42), items.Calls()[0].Args[1])
+
+	defer func() {
+		r.Equal("podiomock: ItemService.ItemDelete called without ItemDeleteFunc", recover())
+	}()
+	service.ItemDelete(context.Background(), 42, nil)
+}
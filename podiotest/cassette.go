@@ -0,0 +1,430 @@
+package podiotest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode selects what a Recorder does with requests
+type Mode string
+
+const (
+	// ModeRecord sends requests to Podio and records them, Stop writes the cassette
+	ModeRecord Mode = "record"
+	// ModeReplay answers requests from the cassette and fails on requests that were not recorded
+	ModeReplay Mode = "replay"
+	// ModePassthrough sends requests to Podio without recording
+	ModePassthrough Mode = "passthrough"
+)
+
+// CassetteVersion is the version of the on-disk format written by a Recorder
+const CassetteVersion = 1
+
+// redacted replaces secrets in recordings
+const redacted = "REDACTED"
+
+// Cassette is the on-disk format of recorded interactions, written as indented JSON
+type Cassette struct {
+	Version      int            `json:"version"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest holds what requests are matched on. Query and Body are canonical:
+// the query is sorted, JSON is compacted with sorted keys and multipart forms are
+// listed part by part, so the same call always gives the same recording.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Header     map[string][]string `json:"header,omitempty"`
+	// JSON holds JSON bodies, other bodies are in Body (or BodyBase64 when they are binary)
+	JSON       json.RawMessage `json:"json,omitempty"`
+	Body       string          `json:"body,omitempty"`
+	BodyBase64 string          `json:"body_base64,omitempty"`
+}
+
+// secretParams are redacted from query strings, form bodies and JSON
+var secretParams = map[string]bool{
+	"oauth_token":   true,
+	"access_token":  true,
+	"refresh_token": true,
+	"client_secret": true,
+	"password":      true,
+	"code":          true,
+	"app_token":     true,
+}
+
+// secretFields are redacted from response bodies
+var secretFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+}
+
+// skippedHeaders are not recorded because they change with every response
+var skippedHeaders = map[string]bool{
+	"Date":       true,
+	"Set-Cookie": true,
+}
+
+// UnmatchedRequestError is returned in replay mode for requests that are not in the cassette.
+// It is not retried by the client.
+type UnmatchedRequestError struct {
+	Cassette string
+	Request  RecordedRequest
+}
+
+func (e *UnmatchedRequestError) Error() string {
+	msg := fmt.Sprintf("podiotest: %s has no recorded interaction for %s %s", e.Cassette, e.Request.Method, e.Request.Path)
+	if e.Request.Query != "" {
+		msg += "?" + e.Request.Query
+	}
+	if e.Request.Body != "" {
+		msg += " with body " + e.Request.Body
+	}
+	return msg
+}
+
+func (e *UnmatchedRequestError) Retryable() bool {
+	return false
+}
+
+// Recorder is an http.RoundTripper that records requests to a cassette file and replays them:
+//
+//	rec, err := podiotest.NewRecorder("testdata/items.json", podiotest.ModeReplay)
+//	client := podio.NewClient(token, podio.WithHTTPClient(rec.HTTPClient()))
+//	...
+//	err = rec.Stop()
+//
+// Authorization headers are never recorded and access tokens, refresh tokens, passwords
+// and client secrets are replaced by REDACTED, in replay mode the token can be anything.
+type Recorder struct {
+	// Transport sends the requests in record and passthrough mode, http.DefaultTransport when nil
+	Transport http.RoundTripper
+
+	path string
+	mode Mode
+
+	mu        sync.Mutex
+	cassette  *Cassette
+	used      []bool
+	unmatched []*UnmatchedRequestError
+}
+
+// NewRecorder creates a recorder for the cassette at path, in replay mode the cassette must exist
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, cassette: &Cassette{Version: CassetteVersion, Interactions: []*Interaction{}}}
+
+	switch mode {
+	case ModeRecord, ModePassthrough:
+	case ModeReplay:
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = cassette
+		r.used = make([]bool, len(cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("podiotest: unknown recorder mode %q", mode)
+	}
+	return r, nil
+}
+
+// LoadCassette reads a cassette written by a Recorder
+func LoadCassette(path string) (*Cassette, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cassette := &Cassette{}
+	if err := json.Unmarshal(buf, cassette); err != nil {
+		return nil, fmt.Errorf("podiotest: cannot read cassette %s: %w", path, err)
+	}
+	if cassette.Version != CassetteVersion {
+		return nil, fmt.Errorf("podiotest: cassette %s has version %d, expected %d", path, cassette.Version, CassetteVersion)
+	}
+	return cassette, nil
+}
+
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// HTTPClient returns an http.Client using the recorder, for podio.WithHTTPClient
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+func (r *Recorder) transport() http.RoundTripper {
+	if r.Transport != nil {
+		return r.Transport
+	}
+	return http.DefaultTransport
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModePassthrough {
+		return r.transport().RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	recorded, err := recordRequest(req, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+	return r.record(req, recorded)
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || interaction.Request != recorded {
+			continue
+		}
+		r.used[i] = true
+		return interaction.Response.httpResponse(req)
+	}
+
+	err := &UnmatchedRequestError{Cassette: r.path, Request: recorded}
+	r.unmatched = append(r.unmatched, err)
+	return nil, err
+}
+
+func (r *Recorder) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	resp, err := r.transport().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request:  recorded,
+		Response: recordResponse(resp, body),
+	})
+	return resp, nil
+}
+
+// Stop writes the cassette in record mode. In replay mode it returns an error
+// when there were requests without a recorded interaction.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch r.mode {
+	case ModeRecord:
+		return r.save()
+	case ModeReplay:
+		if len(r.unmatched) > 0 {
+			msgs := make([]string, len(r.unmatched))
+			for i, err := range r.unmatched {
+				msgs[i] = err.Error()
+			}
+			return fmt.Errorf("podiotest: %d unmatched requests:\n%s", len(r.unmatched), strings.Join(msgs, "\n"))
+		}
+	}
+	return nil
+}
+
+func (r *Recorder) save() error {
+	buf, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(buf, '\n'), 0644)
+}
+
+func recordRequest(req *http.Request, body []byte) (RecordedRequest, error) {
+	query := req.URL.Query()
+	for key := range query {
+		if secretParams[key] {
+			query.Del(key)
+		}
+	}
+
+	canonical, err := canonicalBody(req.Header.Get("Content-Type"), body)
+	if err != nil {
+		return RecordedRequest{}, err
+	}
+	return RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  query.Encode(),
+		Body:   canonical,
+	}, nil
+}
+
+func canonicalBody(contentType string, body []byte) (string, error) {
+	if len(body) == 0 {
+		return "", nil
+	}
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "", err
+		}
+		for key := range form {
+			if secretParams[key] {
+				form.Set(key, redacted)
+			}
+		}
+		return form.Encode(), nil
+
+	case mediaType == "multipart/form-data":
+		// the boundary is random, so list the parts instead
+		reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		var parts []string
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", err
+			}
+			contents, err := io.ReadAll(part)
+			if err != nil {
+				return "", err
+			}
+			name := part.FormName()
+			if filename := part.FileName(); filename != "" {
+				name += "; filename=" + filename
+			}
+			parts = append(parts, name+"\n"+string(contents))
+		}
+		return strings.Join(parts, "\n--\n"), nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err == nil {
+		scrubJSON(value, secretParams)
+		canonical, err := json.Marshal(value)
+		return string(canonical), err
+	}
+	return string(body), nil
+}
+
+func recordResponse(resp *http.Response, body []byte) RecordedResponse {
+	recorded := RecordedResponse{StatusCode: resp.StatusCode, Header: map[string][]string{}}
+	keys := make([]string, 0, len(resp.Header))
+	for key := range resp.Header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !skippedHeaders[key] {
+			recorded.Header[key] = resp.Header[key]
+		}
+	}
+
+	var value interface{}
+	switch {
+	case len(body) == 0:
+	case json.Unmarshal(body, &value) == nil:
+		scrubJSON(value, secretFields)
+		recorded.JSON, _ = json.Marshal(value)
+		delete(recorded.Header, "Content-Length")
+	case utf8.Valid(body):
+		recorded.Body = string(body)
+	default:
+		recorded.BodyBase64 = base64.StdEncoding.EncodeToString(body)
+	}
+	return recorded
+}
+
+func (recorded *RecordedResponse) httpResponse(req *http.Request) (*http.Response, error) {
+	body := []byte(recorded.Body)
+	switch {
+	case len(recorded.JSON) > 0:
+		body = recorded.JSON
+	case recorded.BodyBase64 != "":
+		var err error
+		body, err = base64.StdEncoding.DecodeString(recorded.BodyBase64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	header := http.Header{}
+	for key, values := range recorded.Header {
+		header[key] = append([]string(nil), values...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// scrubJSON redacts secrets in decoded JSON, e.g. the tokens in /oauth/token responses
+func scrubJSON(value interface{}, secrets map[string]bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if secrets[key] {
+				v[key] = redacted
+				continue
+			}
+			scrubJSON(child, secrets)
+		}
+	case []interface{}:
+		for _, child := range v {
+			scrubJSON(child, secrets)
+		}
+	}
+}
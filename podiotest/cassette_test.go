@@ -0,0 +1,78 @@
+package podiotest
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/andreas/podio-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	r := require.New(t)
+
+	server := NewServer()
+	app := server.CreateApp(1, "Projects", podio.AppField{ExternalId: "title", Type: "text", Label: "Title"})
+	path := filepath.Join(t.TempDir(), "cassettes", "items.json")
+
+	// record against the fake server
+	rec, err := NewRecorder(path, ModeRecord)
+	r.NoError(err)
+	token, err := podio.AuthWithUserCredentials("id", "secret", "user", "password", podio.WithBaseURL(server.URL), podio.WithHTTPClient(rec.HTTPClient()))
+	r.NoError(err)
+	client := podio.NewClient(token, podio.WithBaseURL(server.URL), podio.WithHTTPClient(rec.HTTPClient()))
+	itemId, err := client.CreateItem(int(app.Id), "", map[string]interface{}{"title": "Launch"})
+	r.NoError(err)
+	file, err := client.CreateFile("notes.txt", []byte("hello"))
+	r.NoError(err)
+	_, err = client.GetFileContents(file.Link)
+	r.NoError(err)
+	r.NoError(rec.Stop())
+	server.Close()
+
+	buf, err := os.ReadFile(path)
+	r.NoError(err)
+	r.False(strings.Contains(string(buf), token.AccessToken))
+	r.False(strings.Contains(string(buf), token.RefreshToken))
+	r.False(strings.Contains(string(buf), "password=password"))
+
+	// replay without a server, the token does not matter
+	rec, err = NewRecorder(path, ModeReplay)
+	r.NoError(err)
+	token, err = podio.AuthWithUserCredentials("id", "secret", "user", "password", podio.WithBaseURL("http://podio.invalid"), podio.WithHTTPClient(rec.HTTPClient()))
+	r.NoError(err)
+	r.Equal("REDACTED", token.AccessToken)
+	client = podio.NewClient(token, podio.WithBaseURL("http://podio.invalid"), podio.WithHTTPClient(rec.HTTPClient()))
+	replayedId, err := client.CreateItem(int(app.Id), "", map[string]interface{}{"title": "Launch"})
+	r.NoError(err)
+	r.Equal(itemId, replayedId)
+	replayedFile, err := client.CreateFile("notes.txt", []byte("hello"))
+	r.NoError(err)
+	contents, err := client.GetFileContents(replayedFile.Link)
+	r.NoError(err)
+	r.Equal("hello", string(contents))
+	r.NoError(rec.Stop())
+}
+
+func TestReplayFailsOnUnmatchedRequest(t *testing.T) {
+	r := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "empty.json")
+	r.NoError(os.WriteFile(path, []byte(`{"version": 1, "interactions": []}`), 0644))
+
+	rec, err := NewRecorder(path, ModeReplay)
+	r.NoError(err)
+	client := podio.NewClient(&podio.AuthToken{AccessToken: "x"}, podio.WithBaseURL("http://podio.invalid"), podio.WithHTTPClient(rec.HTTPClient()))
+
+	_, err = client.GetItem(1)
+	var unmatched *UnmatchedRequestError
+	r.ErrorAs(err, &unmatched)
+	r.Equal("/item/1", unmatched.Request.Path)
+	r.ErrorContains(rec.Stop(), "1 unmatched requests")
+
+	_, err = NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	r.True(errors.Is(err, os.ErrNotExist))
+}
@@ -0,0 +1,160 @@
+package podiotest
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"github.com/andreas/podio-go"
+)
+
+type file struct {
+	id          int64
+	name        string
+	mimetype    string
+	description string
+	contents    []byte
+	ref         podio.FileRef
+	createdOn   podio.Time
+}
+
+// AddFile stores a file as if it was uploaded and returns its id
+func (s *Server) AddFile(name string, contents []byte) podio.FileID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return podio.FileID(s.addFile(name, contents).id)
+}
+
+func (s *Server) addFile(name string, contents []byte) *file {
+	mimetype := mime.TypeByExtension(filepath.Ext(name))
+	if mimetype == "" {
+		mimetype = http.DetectContentType(contents)
+	}
+	f := &file{
+		id:        s.newId(),
+		name:      name,
+		mimetype:  mimetype,
+		contents:  contents,
+		createdOn: now(),
+	}
+	s.files[f.id] = f
+	return f
+}
+
+func (s *Server) renderFile(f *file) *podio.File {
+	return &podio.File{
+		Id:          podio.FileID(f.id),
+		Name:        f.name,
+		Link:        fmt.Sprintf("%s/podiotest/download/%d", s.URL, f.id),
+		Size:        len(f.contents),
+		Mimetype:    f.mimetype,
+		Description: f.description,
+		Context:     f.ref,
+		CreatedOn:   f.createdOn.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *Server) file(req *http.Request) (*file, error) {
+	fileId, err := pathId(req, "file_id")
+	if err != nil {
+		return nil, err
+	}
+	f, ok := s.files[fileId]
+	if !ok {
+		return nil, notFound("File")
+	}
+	return f, nil
+}
+
+func (s *Server) uploadFile(req *http.Request) (interface{}, error) {
+	source, header, err := req.FormFile("source")
+	if err != nil {
+		return nil, badRequest("missing file in source: " + err.Error())
+	}
+	defer source.Close()
+
+	contents, err := io.ReadAll(source)
+	if err != nil {
+		return nil, err
+	}
+	name := req.FormValue("filename")
+	if name == "" {
+		name = header.Filename
+	}
+	return s.renderFile(s.addFile(name, contents)), nil
+}
+
+func (s *Server) getFile(req *http.Request) (interface{}, error) {
+	f, err := s.file(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.renderFile(f), nil
+}
+
+func (s *Server) updateFile(req *http.Request) (interface{}, error) {
+	f, err := s.file(req)
+	if err != nil {
+		return nil, err
+	}
+	var params struct {
+		Description string `json:"description"`
+	}
+	if err := decodeBody(req, &params); err != nil {
+		return nil, err
+	}
+	f.description = params.Description
+	return nil, nil
+}
+
+func (s *Server) deleteFile(req *http.Request) (interface{}, error) {
+	f, err := s.file(req)
+	if err != nil {
+		return nil, err
+	}
+	delete(s.files, f.id)
+	return nil, nil
+}
+
+func (s *Server) attachFile(req *http.Request) (interface{}, error) {
+	f, err := s.file(req)
+	if err != nil {
+		return nil, err
+	}
+	var params struct {
+		RefType string `json:"ref_type"`
+		RefId   int64  `json:"ref_id"`
+	}
+	if err := decodeBody(req, &params); err != nil {
+		return nil, err
+	}
+
+	if params.RefType == "item" {
+		it, ok := s.items[params.RefId]
+		if !ok {
+			return nil, notFound("Item")
+		}
+		it.fileIds = append(it.fileIds, f.id)
+	}
+	f.ref = podio.FileRef{Id: params.RefId, Type: params.RefType}
+	return nil, nil
+}
+
+func (s *Server) downloadFile(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	f, err := s.file(req)
+	s.mu.Unlock()
+	if err != nil {
+		statusErr := err.(*statusError)
+		writeError(w, statusErr.status, statusErr.errType, statusErr.description)
+		return
+	}
+
+	w.Header().Set("Content-Type", f.mimetype)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", f.name))
+	w.Header().Set("Content-Length", strconv.Itoa(len(f.contents)))
+	w.Write(f.contents)
+}
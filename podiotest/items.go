@@ -0,0 +1,883 @@
+package podiotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andreas/podio-go"
+)
+
+type item struct {
+	id         int64
+	appId      int64
+	appItemId  int
+	externalId string
+	revision   int
+	createdOn  podio.Time
+	lastEditOn podio.Time
+	tags       []string
+	fileIds    []int64
+	// values in the format Podio returns them, by field id
+	values map[podio.FieldID][]interface{}
+}
+
+// CreateApp adds an app with the given fields to the server, field ids are assigned
+// and missing external ids are derived from the label
+func (s *Server) CreateApp(spaceId podio.SpaceID, name string, fields ...podio.AppField) *podio.App {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app := &podio.App{
+		Id:      podio.AppID(s.newId()),
+		Name:    name,
+		Status:  "active",
+		SpaceId: spaceId,
+		Config:  podio.AppConfig{Name: name, ItemName: name, Type: "standard", AllowEdit: true, AllowCreate: true},
+	}
+	for _, field := range fields {
+		s.addField(app, field)
+	}
+	s.apps[int64(app.Id)] = app
+
+	copied := *app
+	copied.Fields = append([]podio.AppField(nil), app.Fields...)
+	return &copied
+}
+
+func (s *Server) addField(app *podio.App, field podio.AppField) podio.AppField {
+	field.Id = podio.FieldID(s.newId())
+	if field.Label == "" {
+		field.Label = field.Config.Label
+	}
+	if field.ExternalId == "" {
+		field.ExternalId = strings.ReplaceAll(strings.ToLower(field.Label), " ", "-")
+	}
+	if field.Status == "" {
+		field.Status = "active"
+	}
+	field.Config.Label = field.Label
+	field.Config.Delta = len(app.Fields)
+	app.Fields = append(app.Fields, field)
+	return field
+}
+
+// ItemCount returns the number of (not deleted) items of an app
+func (s *Server) ItemCount(appId podio.AppID) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.itemCount(appId)
+}
+
+func (s *Server) itemCount(appId podio.AppID) int {
+	count := 0
+	for _, it := range s.items {
+		if it.appId == int64(appId) {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *Server) createApp(req *http.Request) (interface{}, error) {
+	var params struct {
+		SpaceId podio.SpaceID    `json:"space_id"`
+		Config  podio.AppConfig  `json:"config"`
+		Fields  []podio.AppField `json:"fields"`
+	}
+	if err := decodeBody(req, &params); err != nil {
+		return nil, err
+	}
+
+	app := &podio.App{Id: podio.AppID(s.newId()), Name: params.Config.Name, Status: "active", SpaceId: params.SpaceId, Config: params.Config}
+	for _, field := range params.Fields {
+		s.addField(app, field)
+	}
+	s.apps[int64(app.Id)] = app
+	return map[string]podio.AppID{"app_id": app.Id}, nil
+}
+
+func (s *Server) app(req *http.Request) (*podio.App, error) {
+	appId, err := pathId(req, "app_id")
+	if err != nil {
+		return nil, err
+	}
+	app, ok := s.apps[appId]
+	if !ok {
+		return nil, notFound("App")
+	}
+	return app, nil
+}
+
+func (s *Server) getApp(req *http.Request) (interface{}, error) {
+	return s.app(req)
+}
+
+func (s *Server) getApps(req *http.Request) (interface{}, error) {
+	spaceId, err := pathId(req, "space_id")
+	if err != nil {
+		return nil, err
+	}
+	apps := []*podio.App{}
+	for _, app := range s.apps {
+		if int64(app.SpaceId) == spaceId {
+			apps = append(apps, app)
+		}
+	}
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Id < apps[j].Id })
+	return apps, nil
+}
+
+func (s *Server) createAppField(req *http.Request) (interface{}, error) {
+	app, err := s.app(req)
+	if err != nil {
+		return nil, err
+	}
+	var field podio.AppField
+	if err := decodeBody(req, &field); err != nil {
+		return nil, err
+	}
+	return s.addField(app, field), nil
+}
+
+func (s *Server) updateAppField(req *http.Request) (interface{}, error) {
+	app, err := s.app(req)
+	if err != nil {
+		return nil, err
+	}
+	fieldId, err := pathId(req, "field_id")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range app.Fields {
+		if int64(app.Fields[i].Id) != fieldId {
+			continue
+		}
+		var params struct {
+			Label  *string            `json:"label"`
+			Config *podio.FieldConfig `json:"config"`
+		}
+		if err := decodeBody(req, &params); err != nil {
+			return nil, err
+		}
+		if params.Config != nil {
+			app.Fields[i].Config = *params.Config
+		}
+		if params.Label != nil {
+			app.Fields[i].Label = *params.Label
+		}
+		app.CurrentRevision++
+		return map[string]int{"revision": app.CurrentRevision}, nil
+	}
+	return nil, notFound("Field")
+}
+
+// findField looks a field up by field id or external id
+func findField(app *podio.App, key string) (*podio.AppField, bool) {
+	for i := range app.Fields {
+		field := &app.Fields[i]
+		if field.ExternalId == key || strconv.FormatInt(int64(field.Id), 10) == key {
+			return field, true
+		}
+	}
+	return nil, false
+}
+
+type itemParams struct {
+	ExternalId *string                `json:"external_id"`
+	Fields     map[string]interface{} `json:"fields"`
+	Tags       []string               `json:"tags"`
+	FileIds    []int64                `json:"file_ids"`
+}
+
+func (s *Server) createItem(req *http.Request) (interface{}, error) {
+	app, err := s.app(req)
+	if err != nil {
+		return nil, err
+	}
+	var params itemParams
+	if err := decodeBody(req, &params); err != nil {
+		return nil, err
+	}
+
+	it := &item{
+		id:        s.newId(),
+		appId:     int64(app.Id),
+		appItemId: s.nextAppItemId(int64(app.Id)),
+		revision:  0,
+		createdOn: now(),
+		values:    map[podio.FieldID][]interface{}{},
+	}
+	it.lastEditOn = it.createdOn
+	if err := s.applyItemParams(app, it, params); err != nil {
+		return nil, err
+	}
+	s.items[it.id] = it
+	return s.renderItem(it), nil
+}
+
+func (s *Server) nextAppItemId(appId int64) int {
+	max := 0
+	for _, it := range s.items {
+		if it.appId == appId && it.appItemId > max {
+			max = it.appItemId
+		}
+	}
+	return max + 1
+}
+
+func (s *Server) applyItemParams(app *podio.App, it *item, params itemParams) error {
+	if params.ExternalId != nil {
+		it.externalId = *params.ExternalId
+	}
+	if params.Tags != nil {
+		it.tags = params.Tags
+	}
+	if params.FileIds != nil {
+		it.fileIds = params.FileIds
+	}
+	for key, value := range params.Fields {
+		field, ok := findField(app, key)
+		if !ok {
+			return badRequest(fmt.Sprintf("No field with id or external_id %q on app %d", key, app.Id))
+		}
+		values, err := s.readValues(field, value)
+		if err != nil {
+			return badRequest(fmt.Sprintf("Invalid value for field %q: %v", key, err))
+		}
+		if len(values) == 0 {
+			delete(it.values, field.Id)
+		} else {
+			it.values[field.Id] = values
+		}
+	}
+	return nil
+}
+
+func (s *Server) item(req *http.Request) (*item, error) {
+	itemId, err := pathId(req, "item_id")
+	if err != nil {
+		return nil, err
+	}
+	if s.deletedItems[itemId] {
+		return nil, &statusError{http.StatusGone, "gone", "This item has been deleted"}
+	}
+	it, ok := s.items[itemId]
+	if !ok {
+		return nil, notFound("Item")
+	}
+	return it, nil
+}
+
+func (s *Server) getItem(req *http.Request) (interface{}, error) {
+	it, err := s.item(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.renderItem(it), nil
+}
+
+func (s *Server) getItemByExternalId(req *http.Request) (interface{}, error) {
+	app, err := s.app(req)
+	if err != nil {
+		return nil, err
+	}
+	externalId := req.PathValue("external_id")
+	for _, it := range s.items {
+		if it.appId == int64(app.Id) && it.externalId == externalId {
+			return s.renderItem(it), nil
+		}
+	}
+	return nil, notFound("Item")
+}
+
+func (s *Server) updateItem(req *http.Request) (interface{}, error) {
+	it, err := s.item(req)
+	if err != nil {
+		return nil, err
+	}
+	var params itemParams
+	if err := decodeBody(req, &params); err != nil {
+		return nil, err
+	}
+	if err := s.applyItemParams(s.apps[it.appId], it, params); err != nil {
+		return nil, err
+	}
+	it.revision++
+	it.lastEditOn = now()
+	return map[string]interface{}{"revision": it.revision, "title": s.title(it)}, nil
+}
+
+func (s *Server) deleteItem(req *http.Request) (interface{}, error) {
+	it, err := s.item(req)
+	if err != nil {
+		return nil, err
+	}
+	delete(s.items, it.id)
+	s.deletedItems[it.id] = true
+	return nil, nil
+}
+
+func (s *Server) countItems(req *http.Request) (interface{}, error) {
+	app, err := s.app(req)
+	if err != nil {
+		return nil, err
+	}
+	count := 0
+	for _, it := range s.items {
+		if it.appId == int64(app.Id) {
+			count++
+		}
+	}
+	return map[string]int{"count": count}, nil
+}
+
+type filterParams struct {
+	Limit    int                    `json:"limit"`
+	Offset   int                    `json:"offset"`
+	SortBy   string                 `json:"sort_by"`
+	SortDesc *bool                  `json:"sort_desc"`
+	Filters  map[string]interface{} `json:"filters"`
+}
+
+func (s *Server) filterItems(req *http.Request) (interface{}, error) {
+	app, err := s.app(req)
+	if err != nil {
+		return nil, err
+	}
+	params := filterParams{Limit: 30}
+	if err := decodeBody(req, &params); err != nil {
+		return nil, err
+	}
+	if params.Limit > 500 {
+		return nil, badRequest("limit must be at most 500")
+	}
+
+	total := 0
+	matched := []*item{}
+	for _, it := range s.items {
+		if it.appId != int64(app.Id) {
+			continue
+		}
+		total++
+		ok, err := s.matchesFilters(app, it, params.Filters)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, it)
+		}
+	}
+
+	desc := params.SortDesc == nil || *params.SortDesc
+	sort.SliceStable(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		if desc {
+			a, b = b, a
+		}
+		switch params.SortBy {
+		case "title":
+			if ta, tb := s.title(a), s.title(b); ta != tb {
+				return ta < tb
+			}
+		case "last_edit_on":
+			if !a.lastEditOn.Equal(b.lastEditOn.Time) {
+				return a.lastEditOn.Before(b.lastEditOn.Time)
+			}
+		}
+		// created_on, item_id and app_item_id all follow the creation order,
+		// which also breaks ties for the other keys
+		return a.id < b.id
+	})
+
+	filtered := len(matched)
+	if params.Offset < len(matched) {
+		matched = matched[params.Offset:]
+	} else {
+		matched = nil
+	}
+	if len(matched) > params.Limit {
+		matched = matched[:params.Limit]
+	}
+
+	rendered := []map[string]interface{}{}
+	for _, it := range matched {
+		rendered = append(rendered, s.renderItem(it))
+	}
+	return map[string]interface{}{"filtered": filtered, "total": total, "items": rendered}, nil
+}
+
+// matchesFilters supports item_id, external_id, created_on, last_edit_on and field filters,
+// either with a list of values (ids for category/app/contact fields) or a {"from", "to"}
+// range for numbers and dates. Dates must be absolute ("2006-01-02" or
+// "2006-01-02 15:04:05"), relative dates such as "-7d" and filters on who created or
+// edited an item are answered with a 400 unsupported error.
+func (s *Server) matchesFilters(app *podio.App, it *item, filters map[string]interface{}) (bool, error) {
+	for key, filter := range filters {
+		switch key {
+		case "created_by", "created_via", "last_edit_by", "last_edit_via":
+			return false, unsupported(fmt.Sprintf("filters on %s", key))
+		case "created_on", "last_edit_on":
+			at := it.createdOn
+			if key == "last_edit_on" {
+				at = it.lastEditOn
+			}
+			ok, err := matchesDateRange([]string{at.Format("2006-01-02 15:04:05")}, filter)
+			if err != nil || !ok {
+				return false, err
+			}
+			continue
+		case "item_id":
+			if !containsKey(toList(filter), float64(it.id)) {
+				return false, nil
+			}
+			continue
+		case "external_id":
+			if !containsKey(toList(filter), it.externalId) {
+				return false, nil
+			}
+			continue
+		}
+
+		field, ok := findField(app, key)
+		if !ok {
+			return false, badRequest(fmt.Sprintf("Invalid filter key %q", key))
+		}
+		values := it.values[field.Id]
+
+		if field.Type == "date" {
+			starts := []string{}
+			for _, value := range values {
+				if m, ok := value.(map[string]interface{}); ok {
+					if start, ok := m["start"].(string); ok {
+						starts = append(starts, start)
+					}
+				}
+			}
+			ok, err := matchesDateRange(starts, filter)
+			if err != nil || !ok {
+				return false, err
+			}
+			continue
+		}
+
+		if r, ok := filter.(map[string]interface{}); ok {
+			if !matchesRange(values, r) {
+				return false, nil
+			}
+			continue
+		}
+
+		found := false
+		for _, value := range values {
+			if containsKey(toList(filter), valueKey(value)) {
+				found = true
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func matchesRange(values []interface{}, r map[string]interface{}) bool {
+	for _, value := range values {
+		number, ok := valueKey(value).(float64)
+		if !ok {
+			continue
+		}
+		if from, ok := r["from"].(float64); ok && number < from {
+			continue
+		}
+		if to, ok := r["to"].(float64); ok && number > to {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// matchesDateRange checks if one of the dates, formatted as "2006-01-02 15:04:05", lies in
+// the {"from", "to"} range. A "to" without a time includes the whole day.
+func matchesDateRange(dates []string, filter interface{}) (bool, error) {
+	r, ok := filter.(map[string]interface{})
+	if !ok {
+		return false, badRequest("date filters need a from/to range")
+	}
+	from, err := rangeDate(r["from"], " 00:00:00")
+	if err != nil {
+		return false, err
+	}
+	to, err := rangeDate(r["to"], " 23:59:59")
+	if err != nil {
+		return false, err
+	}
+	for _, date := range dates {
+		if from != "" && date < from {
+			continue
+		}
+		if to != "" && date > to {
+			continue
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// rangeDate normalizes a from/to bound so it compares as a string, clock is appended to
+// bare dates
+func rangeDate(v interface{}, clock string) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	date, ok := v.(string)
+	if !ok {
+		return "", badRequest(fmt.Sprintf("invalid date %v", v))
+	}
+	if _, err := time.Parse("2006-01-02", date); err == nil {
+		return date + clock, nil
+	}
+	if _, err := time.Parse("2006-01-02 15:04:05", date); err == nil {
+		return date, nil
+	}
+	return "", unsupported(fmt.Sprintf("date %q, only absolute dates", date))
+}
+
+func containsKey(list []interface{}, key interface{}) bool {
+	for _, v := range list {
+		if v == key {
+			return true
+		}
+		if s, ok := v.(string); ok {
+			if f, err := strconv.ParseFloat(s, 64); err == nil && f == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// valueKey is what filters compare a stored value with
+func valueKey(value interface{}) interface{} {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	switch v := m["value"].(type) {
+	case map[string]interface{}:
+		for _, key := range []string{"id", "item_id", "profile_id", "file_id"} {
+			if id, ok := v[key]; ok {
+				return toFloat(id)
+			}
+		}
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+		return v
+	default:
+		return toFloat(v)
+	}
+	return nil
+}
+
+func toFloat(v interface{}) interface{} {
+	switch n := v.(type) {
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	}
+	return v
+}
+
+func (s *Server) exportItems(req *http.Request) (interface{}, error) {
+	app, err := s.app(req)
+	if err != nil {
+		return nil, err
+	}
+	batch := &podio.Batch{
+		Id:        s.newId(),
+		Name:      fmt.Sprintf("Export of %s", app.Name),
+		Plugin:    "export_" + req.PathValue("format"),
+		Status:    "completed",
+		Completed: int64(s.itemCount(app.Id)),
+		CreatedOn: now(),
+		StartedOn: now(),
+		EndedOn:   now(),
+	}
+	s.batches[batch.Id] = batch
+	return map[string]int64{"batch_id": batch.Id}, nil
+}
+
+func (s *Server) title(it *item) string {
+	app := s.apps[it.appId]
+	for _, field := range app.Fields {
+		if field.Type != "text" {
+			continue
+		}
+		for _, value := range it.values[field.Id] {
+			if m, ok := value.(map[string]interface{}); ok {
+				if text, ok := m["value"].(string); ok {
+					return text
+				}
+			}
+		}
+		break
+	}
+	return ""
+}
+
+func (s *Server) renderItem(it *item) map[string]interface{} {
+	app := s.apps[it.appId]
+
+	fields := []map[string]interface{}{}
+	for _, field := range app.Fields {
+		values, ok := it.values[field.Id]
+		if !ok {
+			continue
+		}
+		settings := json.RawMessage(`{}`)
+		if field.Config.Settings != nil {
+			settings = *field.Config.Settings
+		}
+		fields = append(fields, map[string]interface{}{
+			"field_id":    field.Id,
+			"external_id": field.ExternalId,
+			"type":        field.Type,
+			"label":       field.Label,
+			"status":      field.Status,
+			"config":      map[string]interface{}{"label": field.Label, "settings": settings},
+			"values":      values,
+		})
+	}
+
+	files := []*podio.File{}
+	for _, fileId := range it.fileIds {
+		if f, ok := s.files[fileId]; ok {
+			files = append(files, s.renderFile(f))
+		}
+	}
+
+	tags := it.tags
+	if tags == nil {
+		tags = []string{}
+	}
+	createdOn, lastEditOn := it.createdOn, it.lastEditOn
+
+	return map[string]interface{}{
+		"item_id":               it.id,
+		"app_item_id":           it.appItemId,
+		"app_item_id_formatted": strconv.Itoa(it.appItemId),
+		"external_id":           it.externalId,
+		"title":                 s.title(it),
+		"revision":              it.revision,
+		"app":                   map[string]interface{}{"app_id": app.Id, "name": app.Name, "space_id": app.SpaceId},
+		"created_on":            &createdOn,
+		"last_event_on":         &lastEditOn,
+		"last_edit_on":          &lastEditOn,
+		"current_revision":      map[string]interface{}{"revision": it.revision, "created_on": &lastEditOn},
+		"tags":                  tags,
+		"files":                 files,
+		"fields":                fields,
+		"link":                  fmt.Sprintf("%s/podiotest/app/%d/items/%d", s.URL, app.Id, it.appItemId),
+	}
+}
+
+func toList(value interface{}) []interface{} {
+	if list, ok := value.([]interface{}); ok {
+		return list
+	}
+	if value == nil {
+		return nil
+	}
+	return []interface{}{value}
+}
+
+// readValues converts values in Podio's write format (as used for creating and
+// updating items) into the format Podio returns when reading an item
+func (s *Server) readValues(field *podio.AppField, value interface{}) ([]interface{}, error) {
+	values := []interface{}{}
+	for _, v := range toList(value) {
+		read, err := s.readValue(field, v)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, read)
+	}
+	return values, nil
+}
+
+func (s *Server) readValue(field *podio.AppField, value interface{}) (interface{}, error) {
+	m, isMap := value.(map[string]interface{})
+
+	switch field.Type {
+	case "text", "tag":
+		if isMap {
+			return m, nil
+		}
+		return map[string]interface{}{"value": fmt.Sprint(value)}, nil
+
+	case "location":
+		if isMap {
+			return m, nil
+		}
+		return map[string]interface{}{"value": fmt.Sprint(value), "formatted": fmt.Sprint(value)}, nil
+
+	case "number":
+		if isMap {
+			value = m["value"]
+		}
+		number, err := toNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"value": strconv.FormatFloat(number, 'f', 4, 64)}, nil
+
+	case "money":
+		if !isMap {
+			return nil, fmt.Errorf("money values need a value and a currency")
+		}
+		number, err := toNumber(m["value"])
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"value": strconv.FormatFloat(number, 'f', 4, 64), "currency": m["currency"]}, nil
+
+	case "progress", "duration", "question", "member", "video":
+		if isMap {
+			value = m["value"]
+		}
+		number, err := toNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"value": int64(number)}, nil
+
+	case "category":
+		if isMap {
+			value = m["value"]
+		}
+		return map[string]interface{}{"value": categoryOption(field, value)}, nil
+
+	case "app":
+		if isMap {
+			value = m["value"]
+		}
+		id, err := toNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		ref := map[string]interface{}{"item_id": int64(id)}
+		if it, ok := s.items[int64(id)]; ok {
+			ref["title"] = s.title(it)
+			ref["app_item_id"] = it.appItemId
+			ref["app"] = map[string]interface{}{"app_id": it.appId}
+		}
+		return map[string]interface{}{"value": ref}, nil
+
+	case "contact":
+		if isMap {
+			value = m["value"]
+		}
+		id, err := toNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"value": map[string]interface{}{"profile_id": int64(id), "type": "user"}}, nil
+
+	case "image":
+		if isMap {
+			value = m["value"]
+		}
+		id, err := toNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		f, ok := s.files[int64(id)]
+		if !ok {
+			return nil, fmt.Errorf("file %d not found", int64(id))
+		}
+		return map[string]interface{}{"value": s.renderFile(f)}, nil
+
+	case "date":
+		if !isMap {
+			return nil, fmt.Errorf("date values need a start")
+		}
+		return readDate(m), nil
+	}
+
+	// email, phone, tel, embed, ...: Podio returns what was written
+	if isMap {
+		return m, nil
+	}
+	return map[string]interface{}{"value": value}, nil
+}
+
+func toNumber(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	}
+	return 0, fmt.Errorf("%v is not a number", value)
+}
+
+func categoryOption(field *podio.AppField, value interface{}) map[string]interface{} {
+	var settings struct {
+		Options []map[string]interface{} `json:"options"`
+	}
+	if field.Config.Settings != nil {
+		json.Unmarshal(*field.Config.Settings, &settings)
+	}
+	for _, option := range settings.Options {
+		if option["id"] == value || option["text"] == value {
+			return option
+		}
+	}
+	return map[string]interface{}{"id": value, "status": "active"}
+}
+
+// readDate accepts start/end, start_date/start_time and start_utc/end_utc like Podio
+func readDate(m map[string]interface{}) map[string]interface{} {
+	read := map[string]interface{}{}
+	for _, prefix := range []string{"start", "end"} {
+		var date, clock string
+		if v, ok := m[prefix].(string); ok {
+			date, clock, _ = strings.Cut(v, " ")
+		}
+		if v, ok := m[prefix+"_utc"].(string); ok {
+			date, clock, _ = strings.Cut(v, " ")
+		}
+		if v, ok := m[prefix+"_date"].(string); ok {
+			date = v
+		}
+		if v, ok := m[prefix+"_time"].(string); ok {
+			clock = v
+		}
+		if date == "" {
+			continue
+		}
+
+		read[prefix+"_date"] = date
+		read[prefix+"_date_utc"] = date
+		if clock == "" {
+			read[prefix] = date + " 00:00:00"
+			read[prefix+"_time"] = nil
+			read[prefix+"_utc"] = date
+			continue
+		}
+		read[prefix] = date + " " + clock
+		read[prefix+"_time"] = clock
+		read[prefix+"_time_utc"] = clock
+		read[prefix+"_utc"] = date + " " + clock
+	}
+	return read
+}
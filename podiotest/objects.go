@@ -0,0 +1,241 @@
+package podiotest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/andreas/podio-go"
+)
+
+type hook struct {
+	podio.Hook
+	refType string
+	refId   int64
+	code    string
+}
+
+// Hook returns a hook with its verification code, which Podio would send to the hook url
+func (s *Server) Hook(hookId int64) (h podio.Hook, verificationCode string, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.hooks[hookId]
+	if !ok {
+		return podio.Hook{}, "", false
+	}
+	return stored.Hook, stored.code, true
+}
+
+// AddBatch stores a batch, e.g. to test waiting for an export
+func (s *Server) AddBatch(batch podio.Batch) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if batch.Id == 0 {
+		batch.Id = s.newId()
+	}
+	s.batches[batch.Id] = &batch
+	return batch.Id
+}
+
+func refId(req *http.Request) (string, int64, error) {
+	id, err := pathId(req, "ref_id")
+	if err != nil {
+		return "", 0, err
+	}
+	return req.PathValue("ref_type"), id, nil
+}
+
+func (s *Server) createComment(req *http.Request) (interface{}, error) {
+	refType, id, err := refId(req)
+	if err != nil {
+		return nil, err
+	}
+	var params struct {
+		Value      string  `json:"value"`
+		ExternalId string  `json:"external_id"`
+		FileIds    []int64 `json:"file_ids"`
+	}
+	if err := decodeBody(req, &params); err != nil {
+		return nil, err
+	}
+	if params.Value == "" {
+		return nil, badRequest("value must not be empty")
+	}
+
+	comment := &podio.Comment{
+		Id:         s.newId(),
+		ExternalId: params.ExternalId,
+		Value:      params.Value,
+		RichValue:  params.Value,
+		Ref:        &podio.Reference{Id: int(id), Type: refType},
+		Files:      []*podio.File{},
+		CreatedOn:  now(),
+	}
+	for _, fileId := range params.FileIds {
+		if f, ok := s.files[fileId]; ok {
+			comment.Files = append(comment.Files, s.renderFile(f))
+		}
+	}
+	s.comments[comment.Id] = comment
+	return comment, nil
+}
+
+func (s *Server) getComments(req *http.Request) (interface{}, error) {
+	refType, id, err := refId(req)
+	if err != nil {
+		return nil, err
+	}
+	comments := []*podio.Comment{}
+	for _, comment := range s.comments {
+		if comment.Ref.Type == refType && int64(comment.Ref.Id) == id {
+			comments = append(comments, comment)
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool { return comments[i].Id < comments[j].Id })
+	return comments, nil
+}
+
+func (s *Server) comment(req *http.Request) (*podio.Comment, error) {
+	commentId, err := pathId(req, "comment_id")
+	if err != nil {
+		return nil, err
+	}
+	comment, ok := s.comments[commentId]
+	if !ok {
+		return nil, notFound("Comment")
+	}
+	return comment, nil
+}
+
+func (s *Server) getComment(req *http.Request) (interface{}, error) {
+	return s.comment(req)
+}
+
+func (s *Server) updateComment(req *http.Request) (interface{}, error) {
+	comment, err := s.comment(req)
+	if err != nil {
+		return nil, err
+	}
+	var params struct {
+		Value string `json:"value"`
+	}
+	if err := decodeBody(req, &params); err != nil {
+		return nil, err
+	}
+	comment.Value = params.Value
+	comment.RichValue = params.Value
+	edited := now().Format("2006-01-02 15:04:05")
+	comment.LastEditOn = &edited
+	return nil, nil
+}
+
+func (s *Server) deleteComment(req *http.Request) (interface{}, error) {
+	comment, err := s.comment(req)
+	if err != nil {
+		return nil, err
+	}
+	delete(s.comments, comment.Id)
+	return nil, nil
+}
+
+func (s *Server) createHook(req *http.Request) (interface{}, error) {
+	refType, id, err := refId(req)
+	if err != nil {
+		return nil, err
+	}
+	var params struct {
+		Url  string `json:"url"`
+		Type string `json:"type"`
+	}
+	if err := decodeBody(req, &params); err != nil {
+		return nil, err
+	}
+	if params.Url == "" || params.Type == "" {
+		return nil, badRequest("url and type are required")
+	}
+
+	h := &hook{
+		Hook:    podio.Hook{Id: s.newId(), Status: "inactive", Type: params.Type, Url: params.Url},
+		refType: refType,
+		refId:   id,
+	}
+	s.hooks[h.Id] = h
+	return map[string]int64{"hook_id": h.Id}, nil
+}
+
+func (s *Server) getHooks(req *http.Request) (interface{}, error) {
+	refType, id, err := refId(req)
+	if err != nil {
+		return nil, err
+	}
+	hooks := []podio.Hook{}
+	for _, h := range s.hooks {
+		if h.refType == refType && h.refId == id {
+			hooks = append(hooks, h.Hook)
+		}
+	}
+	sort.Slice(hooks, func(i, j int) bool { return hooks[i].Id < hooks[j].Id })
+	return hooks, nil
+}
+
+func (s *Server) hook(req *http.Request) (*hook, error) {
+	hookId, err := pathId(req, "hook_id")
+	if err != nil {
+		return nil, err
+	}
+	h, ok := s.hooks[hookId]
+	if !ok {
+		return nil, notFound("Hook")
+	}
+	return h, nil
+}
+
+func (s *Server) deleteHook(req *http.Request) (interface{}, error) {
+	h, err := s.hook(req)
+	if err != nil {
+		return nil, err
+	}
+	delete(s.hooks, h.Id)
+	return nil, nil
+}
+
+// requestHookVerification only generates the code, get it with Server.Hook
+func (s *Server) requestHookVerification(req *http.Request) (interface{}, error) {
+	h, err := s.hook(req)
+	if err != nil {
+		return nil, err
+	}
+	h.code = strconv.FormatInt(s.newId(), 16)
+	return nil, nil
+}
+
+func (s *Server) validateHook(req *http.Request) (interface{}, error) {
+	h, err := s.hook(req)
+	if err != nil {
+		return nil, err
+	}
+	var params struct {
+		Code string `json:"code"`
+	}
+	if err := decodeBody(req, &params); err != nil {
+		return nil, err
+	}
+	if h.code == "" || params.Code != h.code {
+		return nil, badRequest(fmt.Sprintf("Invalid verification code for hook %d", h.Id))
+	}
+	h.Status = "active"
+	return nil, nil
+}
+
+func (s *Server) getBatch(req *http.Request) (interface{}, error) {
+	batchId, err := pathId(req, "batch_id")
+	if err != nil {
+		return nil, err
+	}
+	batch, ok := s.batches[batchId]
+	if !ok {
+		return nil, notFound("Batch")
+	}
+	return batch, nil
+}
@@ -0,0 +1,411 @@
+// Package podiotest provides an in-memory fake of the Podio API for tests.
+//
+//	server := podiotest.NewServer()
+//	defer server.Close()
+//
+//	app := server.CreateApp(1, "Projects", podio.AppField{ExternalId: "title", Type: "text", Label: "Title"})
+//	client := server.Client()
+//	itemId, err := client.CreateItem(int(app.Id), "", map[string]interface{}{"title": "Launch"})
+//
+// It covers OAuth tokens, apps, app fields, items (create, get, update, delete, filter),
+// file upload and download, comments, hooks and batches. Every API response carries
+// X-Rate-Limit-* headers and errors can be injected with Inject.
+//
+// Item filters cover item_id, external_id, created_on, last_edit_on and field values.
+// Filters the fake can't evaluate, such as created_by or relative dates, fail with a
+// 400 response of type "unsupported" instead of being ignored.
+package podiotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/andreas/podio-go"
+)
+
+// DefaultRateLimit is the hourly rate limit the server starts with
+const DefaultRateLimit = 5000
+
+// DefaultRateLimitWindow is how long it takes the rate limit to reset
+const DefaultRateLimitWindow = time.Hour
+
+// Server is a fake Podio API, safe for concurrent use
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	nextId int64
+
+	tokens        map[string]bool
+	refreshTokens map[string]bool
+
+	rateLimit     int
+	rateRemaining int
+	rateWindow    time.Duration
+	rateReset     time.Time
+	faults        []*Fault
+
+	apps         map[int64]*podio.App
+	items        map[int64]*item
+	deletedItems map[int64]bool
+	files        map[int64]*file
+	comments     map[int64]*podio.Comment
+	hooks        map[int64]*hook
+	batches      map[int64]*podio.Batch
+}
+
+// Fault makes the server answer matching requests with an error instead of handling them
+type Fault struct {
+	// Method and Route select the requests, empty matches everything.
+	// Route is a route template as produced by podio.RouteTemplate, e.g. /item/{id}
+	Method string
+	Route  string
+
+	Status      int
+	Type        string // Podio error type, e.g. "not_found"
+	Description string
+	RetryAfter  int // seconds, sent as Retry-After header when > 0
+	// Times is how many requests fail, 0 means once and a negative value forever
+	Times int
+}
+
+// NewServer starts a fake Podio API, call Close when done
+func NewServer() *Server {
+	s := &Server{
+		nextId:        1000,
+		tokens:        map[string]bool{},
+		refreshTokens: map[string]bool{},
+		rateLimit:     DefaultRateLimit,
+		rateRemaining: DefaultRateLimit,
+		rateWindow:    DefaultRateLimitWindow,
+		apps:          map[int64]*podio.App{},
+		items:         map[int64]*item{},
+		deletedItems:  map[int64]bool{},
+		files:         map[int64]*file{},
+		comments:      map[int64]*podio.Comment{},
+		hooks:         map[int64]*hook{},
+		batches:       map[int64]*podio.Batch{},
+	}
+	s.Server = httptest.NewServer(s.handler())
+	return s
+}
+
+// Client returns a client for the server authenticated with a fresh token
+func (s *Server) Client(opts ...podio.ClientOption) *podio.Client {
+	opts = append([]podio.ClientOption{podio.WithBaseURL(s.URL)}, opts...)
+	return podio.NewClient(s.Token(), opts...)
+}
+
+// Token issues a valid auth token without going through /oauth/token
+func (s *Server) Token() *podio.AuthToken {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.issueToken()
+}
+
+// ExpireTokens invalidates all issued access tokens (refresh tokens stay valid),
+// the next API request answers with 401 invalid_token
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = map[string]bool{}
+}
+
+// SetRateLimit sets the rate limit and what is left of it, at 0 requests get a 420 response
+// until the window ends. It starts a new window.
+func (s *Server) SetRateLimit(limit, remaining int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimit = limit
+	s.rateRemaining = remaining
+	s.rateReset = time.Now().Add(s.rateWindow)
+}
+
+// SetRateLimitWindow sets how long a rate limit window lasts, when it ends the full limit
+// is available again. It starts a new window.
+func (s *Server) SetRateLimitWindow(window time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateWindow = window
+	s.rateReset = time.Now().Add(window)
+}
+
+// Inject adds a fault, faults are checked in the order they were added
+func (s *Server) Inject(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if fault.Times == 0 {
+		fault.Times = 1
+	}
+	s.faults = append(s.faults, &fault)
+}
+
+func (s *Server) newId() int64 {
+	s.nextId++
+	return s.nextId
+}
+
+func (s *Server) issueToken() *podio.AuthToken {
+	id := s.newId()
+	token := &podio.AuthToken{
+		AccessToken:  fmt.Sprintf("podiotest-access-%d", id),
+		RefreshToken: fmt.Sprintf("podiotest-refresh-%d", id),
+		TokenType:    "bearer",
+		ExpiresIn:    28800,
+		ExpiresAt:    time.Now().Add(28800 * time.Second),
+	}
+	s.tokens[token.AccessToken] = true
+	s.refreshTokens[token.RefreshToken] = true
+	return token
+}
+
+// apiError is the error body Podio sends
+type apiError struct {
+	Type        string      `json:"error"`
+	Description string      `json:"error_description"`
+	Detail      interface{} `json:"error_detail"`
+	Parameters  interface{} `json:"error_parameters"`
+	Propagate   bool        `json:"error_propagate"`
+}
+
+type handlerFunc func(req *http.Request) (interface{}, error)
+
+// statusError is returned by handlers to answer with a Podio error
+type statusError struct {
+	status      int
+	errType     string
+	description string
+}
+
+func (e *statusError) Error() string {
+	return e.errType + ": " + e.description
+}
+
+func notFound(what string) error {
+	return &statusError{http.StatusNotFound, "not_found", what + " not found"}
+}
+
+func badRequest(description string) error {
+	return &statusError{http.StatusBadRequest, "invalid_value", description}
+}
+
+// unsupported is the error for requests the real API would answer but the fake can't
+func unsupported(what string) error {
+	return &statusError{http.StatusBadRequest, "unsupported", "podiotest does not support " + what}
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	s.routes(mux)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Podio does not care about trailing slashes
+		if req.URL.Path != "/" {
+			req.URL.Path = strings.TrimSuffix(req.URL.Path, "/")
+		}
+
+		kind := "api"
+		switch {
+		case req.URL.Path == "/oauth/token":
+			kind = "auth"
+		case strings.HasPrefix(req.URL.Path, "/podiotest/download/"):
+			kind = "file"
+		}
+
+		s.mu.Lock()
+		if fault := s.matchFault(req); fault != nil {
+			s.mu.Unlock()
+			if fault.RetryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(fault.RetryAfter))
+			}
+			writeError(w, fault.Status, fault.Type, fault.Description)
+			return
+		}
+
+		if kind != "auth" && !s.authorized(req) {
+			s.mu.Unlock()
+			writeError(w, http.StatusUnauthorized, "invalid_token", "expired_token")
+			return
+		}
+
+		if kind == "api" {
+			if now := time.Now(); !now.Before(s.rateReset) {
+				s.rateRemaining = s.rateLimit
+				s.rateReset = now.Add(s.rateWindow)
+			}
+			if s.rateRemaining <= 0 {
+				s.setRateLimitHeaders(w)
+				wait := int(math.Ceil(time.Until(s.rateReset).Seconds()))
+				s.mu.Unlock()
+				w.Header().Set("Retry-After", strconv.Itoa(wait))
+				writeError(w, 420, "rate_limit", fmt.Sprintf("You have hit the rate limit. Please wait %d seconds before trying again", wait))
+				return
+			}
+			s.rateRemaining--
+			s.setRateLimitHeaders(w)
+		}
+		s.mu.Unlock()
+
+		mux.ServeHTTP(w, req)
+	})
+}
+
+func (s *Server) setRateLimitHeaders(w http.ResponseWriter) {
+	w.Header().Set("X-Rate-Limit-Limit", strconv.Itoa(s.rateLimit))
+	w.Header().Set("X-Rate-Limit-Remaining", strconv.Itoa(s.rateRemaining))
+}
+
+func (s *Server) matchFault(req *http.Request) *Fault {
+	route := podio.RouteTemplate(req.URL.Path)
+	for i, fault := range s.faults {
+		if fault.Method != "" && fault.Method != req.Method {
+			continue
+		}
+		if fault.Route != "" && fault.Route != route {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+func (s *Server) authorized(req *http.Request) bool {
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "OAuth2 ")
+	if token == "" {
+		token = req.URL.Query().Get("oauth_token")
+	}
+	return s.tokens[token]
+}
+
+// handle registers a handler that answers with the JSON of its result
+func (s *Server) handle(mux *http.ServeMux, pattern string, h handlerFunc) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, req *http.Request) {
+		// results share apps and items with the server, encode them before other requests change them
+		s.mu.Lock()
+		result, err := h(req)
+		var body []byte
+		if err == nil && result != nil {
+			body, err = json.Marshal(result)
+		}
+		s.mu.Unlock()
+
+		if err != nil {
+			if statusErr, ok := err.(*statusError); ok {
+				writeError(w, statusErr.status, statusErr.errType, statusErr.description)
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "server_error", err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		w.Write(body)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if body == nil {
+		return
+	}
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, errType, description string) {
+	writeJSON(w, status, &apiError{Type: errType, Description: description})
+}
+
+func (s *Server) routes(mux *http.ServeMux) {
+	s.handle(mux, "POST /oauth/token", s.token)
+
+	s.handle(mux, "POST /app", s.createApp)
+	s.handle(mux, "GET /app/{app_id}", s.getApp)
+	s.handle(mux, "GET /app/space/{space_id}", s.getApps)
+	s.handle(mux, "POST /app/{app_id}/field", s.createAppField)
+	s.handle(mux, "PUT /app/{app_id}/field/{field_id}", s.updateAppField)
+
+	s.handle(mux, "POST /item/app/{app_id}", s.createItem)
+	s.handle(mux, "POST /item/app/{app_id}/filter", s.filterItems)
+	s.handle(mux, "GET /item/app/{app_id}/count", s.countItems)
+	s.handle(mux, "GET /item/app/{app_id}/external_id/{external_id}", s.getItemByExternalId)
+	s.handle(mux, "POST /item/app/{app_id}/export/{format}", s.exportItems)
+	s.handle(mux, "GET /item/{item_id}", s.getItem)
+	s.handle(mux, "PUT /item/{item_id}", s.updateItem)
+	s.handle(mux, "DELETE /item/{item_id}", s.deleteItem)
+
+	s.handle(mux, "POST /file", s.uploadFile)
+	s.handle(mux, "GET /file/{file_id}", s.getFile)
+	s.handle(mux, "PUT /file/{file_id}", s.updateFile)
+	s.handle(mux, "DELETE /file/{file_id}", s.deleteFile)
+	s.handle(mux, "POST /file/{file_id}/attach", s.attachFile)
+	mux.HandleFunc("GET /podiotest/download/{file_id}", s.downloadFile)
+
+	s.handle(mux, "POST /comment/{ref_type}/{ref_id}", s.createComment)
+	s.handle(mux, "GET /comment/{ref_type}/{ref_id}", s.getComments)
+	s.handle(mux, "GET /comment/{comment_id}", s.getComment)
+	s.handle(mux, "PUT /comment/{comment_id}", s.updateComment)
+	s.handle(mux, "DELETE /comment/{comment_id}", s.deleteComment)
+
+	s.handle(mux, "POST /hook/{ref_type}/{ref_id}", s.createHook)
+	s.handle(mux, "GET /hook/{ref_type}/{ref_id}", s.getHooks)
+	s.handle(mux, "DELETE /hook/{hook_id}", s.deleteHook)
+	s.handle(mux, "POST /hook/{hook_id}/verify/request", s.requestHookVerification)
+	s.handle(mux, "POST /hook/{hook_id}/verify/validate", s.validateHook)
+
+	s.handle(mux, "GET /batch/{batch_id}", s.getBatch)
+}
+
+func (s *Server) token(req *http.Request) (interface{}, error) {
+	if err := req.ParseForm(); err != nil {
+		return nil, badRequest(err.Error())
+	}
+
+	switch req.PostForm.Get("grant_type") {
+	case "password", "app", "authorization_code":
+	case "refresh_token":
+		refreshToken := req.PostForm.Get("refresh_token")
+		if !s.refreshTokens[refreshToken] {
+			return nil, &statusError{http.StatusBadRequest, "invalid_grant", "Invalid refresh token"}
+		}
+		delete(s.refreshTokens, refreshToken)
+	default:
+		return nil, &statusError{http.StatusBadRequest, "unsupported_grant_type", "Unsupported grant type"}
+	}
+	return s.issueToken(), nil
+}
+
+func pathId(req *http.Request, name string) (int64, error) {
+	id, err := strconv.ParseInt(req.PathValue(name), 10, 64)
+	if err != nil {
+		return 0, badRequest(fmt.Sprintf("invalid %s %q", name, req.PathValue(name)))
+	}
+	return id, nil
+}
+
+func decodeBody(req *http.Request, out interface{}) error {
+	if req.ContentLength == 0 {
+		return nil
+	}
+	if err := json.NewDecoder(req.Body).Decode(out); err != nil {
+		return badRequest(err.Error())
+	}
+	return nil
+}
+
+func now() podio.Time {
+	return podio.Time{Time: time.Now().UTC().Truncate(time.Second)}
+}
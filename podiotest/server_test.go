@@ -0,0 +1,183 @@
+package podiotest
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/andreas/podio-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemWorkflow(t *testing.T) {
+	r := require.New(t)
+
+	server := NewServer()
+	defer server.Close()
+
+	statusSettings := json.RawMessage(`{"options": [{"id": 1, "text": "Open", "status": "active", "color": "DCEBD8"}, {"id": 2, "text": "Done", "status": "active", "color": "F7F0C5"}]}`)
+	app := server.CreateApp(1, "Projects",
+		podio.AppField{ExternalId: "title", Type: "text", Label: "Title"},
+		podio.AppField{ExternalId: "status", Type: "category", Label: "Status", Config: podio.FieldConfig{Settings: &statusSettings}},
+		podio.AppField{ExternalId: "budget", Type: "number", Label: "Budget"},
+	)
+	items := server.Client().Items()
+	ctx := context.Background()
+
+	itemId, err := items.CreateItem(ctx, app.Id, "launch", map[string]interface{}{"title": "Launch", "status": 1, "budget": 100})
+	r.NoError(err)
+	_, err = items.CreateItem(ctx, app.Id, "", map[string]interface{}{"title": "Cleanup", "status": 2, "budget": 5})
+	r.NoError(err)
+
+	item, err := items.GetItemByExternalID(ctx, app.Id, "launch")
+	r.NoError(err)
+	r.Equal(itemId, item.Id)
+	r.Equal("Launch", item.Title)
+	r.Len(item.Fields, 3)
+	r.Equal("Open", item.Fields[1].Values.([]podio.CategoryValue)[0].Value.Text)
+	r.Equal(100.0, item.Fields[2].Values.([]podio.NumberValue)[0].Value)
+
+	r.NoError(items.UpdateItem(ctx, itemId, map[string]interface{}{"status": 2}))
+	item, err = items.GetItem(ctx, itemId)
+	r.NoError(err)
+	r.Equal("Done", item.Fields[1].Values.([]podio.CategoryValue)[0].Value.Text)
+	r.Equal(1, item.Revision)
+
+	list, err := items.FilterItems(ctx, app.Id, map[string]interface{}{"filters": map[string]interface{}{"budget": map[string]interface{}{"from": 50}}})
+	r.NoError(err)
+	r.Equal(1, list.Filtered)
+	r.Equal(2, list.Total)
+	r.Equal(itemId, list.Items[0].Id)
+
+	list, err = items.FilterItems(ctx, app.Id, map[string]interface{}{"filters": map[string]interface{}{"status": []int{2}}, "sort_by": "title", "sort_desc": false})
+	r.NoError(err)
+	r.Len(list.Items, 2)
+	r.Equal("Cleanup", list.Items[0].Title)
+
+	list, err = items.FilterItems(ctx, app.Id, map[string]interface{}{"sort_by": "title"})
+	r.NoError(err)
+	r.Equal("Launch", list.Items[0].Title)
+
+	list, err = items.FilterItems(ctx, app.Id, map[string]interface{}{"filters": map[string]interface{}{"created_on": map[string]interface{}{"from": "2000-01-01", "to": "2999-12-31"}}})
+	r.NoError(err)
+	r.Equal(2, list.Filtered)
+	list, err = items.FilterItems(ctx, app.Id, map[string]interface{}{"filters": map[string]interface{}{"last_edit_on": map[string]interface{}{"to": "2000-01-01"}}})
+	r.NoError(err)
+	r.Equal(0, list.Filtered)
+	_, err = items.FilterItems(ctx, app.Id, map[string]interface{}{"filters": map[string]interface{}{"created_by": map[string]interface{}{"type": "user", "id": 1}}})
+	r.ErrorContains(err, "does not support")
+	_, err = items.FilterItems(ctx, app.Id, map[string]interface{}{"filters": map[string]interface{}{"created_on": map[string]interface{}{"from": "-7d"}}})
+	r.ErrorContains(err, "does not support")
+
+	r.NoError(items.ItemDelete(ctx, itemId, nil))
+	_, err = items.GetItem(ctx, itemId)
+	r.ErrorIs(err, podio.ErrGone)
+	r.Equal(1, server.ItemCount(app.Id))
+
+	other := server.CreateApp(1, "Other", podio.AppField{ExternalId: "title", Type: "text", Label: "Title"})
+	_, err = items.CreateItem(ctx, other.Id, "", map[string]interface{}{"title": "Elsewhere"})
+	r.NoError(err)
+	batchId, err := items.ExportItems(ctx, app.Id, "xlsx", nil)
+	r.NoError(err)
+	batch, err := server.Client().Batches().GetBatch(ctx, batchId)
+	r.NoError(err)
+	r.Equal(int64(1), batch.Completed)
+}
+
+func TestFilterSortTies(t *testing.T) {
+	r := require.New(t)
+
+	server := NewServer()
+	defer server.Close()
+	app := server.CreateApp(1, "Projects", podio.AppField{ExternalId: "title", Type: "text", Label: "Title"})
+	items := server.Client().Items()
+	ctx := context.Background()
+
+	ids := []int64{}
+	for i := 0; i < 5; i++ {
+		id, err := items.CreateItem(ctx, app.Id, "", map[string]interface{}{"title": "Same"})
+		r.NoError(err)
+		ids = append(ids, int64(id))
+	}
+
+	// equal titles fall back to the item id, in the same direction
+	list, err := items.FilterItems(ctx, app.Id, map[string]interface{}{"sort_by": "title", "sort_desc": false})
+	r.NoError(err)
+	for i, item := range list.Items {
+		r.Equal(ids[i], int64(item.Id))
+	}
+	list, err = items.FilterItems(ctx, app.Id, map[string]interface{}{"sort_by": "title"})
+	r.NoError(err)
+	for i, item := range list.Items {
+		r.Equal(ids[len(ids)-1-i], int64(item.Id))
+	}
+}
+
+func TestFilesCommentsAndHooks(t *testing.T) {
+	r := require.New(t)
+
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	file, err := client.CreateFile("notes.txt", []byte("hello"))
+	r.NoError(err)
+	r.Equal("notes.txt", file.Name)
+	contents, err := client.GetFileContents(file.Link)
+	r.NoError(err)
+	r.Equal("hello", string(contents))
+
+	comment, err := client.Comment("item", 42, "Looks good", nil)
+	r.NoError(err)
+	r.NoError(client.UpdateComment(comment.Id, "Looks great", nil))
+	comments, err := client.GetComments("item", 42)
+	r.NoError(err)
+	r.Len(comments, 1)
+	r.Equal("Looks great", comments[0].Value)
+
+	hook, err := client.CreateHook("app", 7, "https://example.com/hook", "item.create")
+	r.NoError(err)
+	r.NoError(client.VerifyHook(hook.Id))
+	_, code, ok := server.Hook(hook.Id)
+	r.True(ok)
+	r.Error(client.ValidateHook(hook.Id, "wrong"))
+	r.NoError(client.ValidateHook(hook.Id, code))
+	hooks, err := client.FindHooks("app", 7)
+	r.NoError(err)
+	r.Equal("active", hooks[0].Status)
+}
+
+func TestFaultsRateLimitAndTokens(t *testing.T) {
+	r := require.New(t)
+
+	server := NewServer()
+	defer server.Close()
+	client := server.Client(podio.WithTokenRefresh("id", "secret"), podio.WithRetryPolicy(&podio.RetryPolicy{MaxAttempts: 1}))
+
+	server.Inject(Fault{Method: "GET", Route: "/app/{id}", Status: 404, Type: "not_found", Description: "App not found"})
+	_, err := client.GetApp(1)
+	r.ErrorIs(err, podio.ErrNotFound)
+
+	app := server.CreateApp(1, "Projects", podio.AppField{ExternalId: "title", Type: "text", Label: "Title"})
+	_, err = client.GetApp(int64(app.Id))
+	r.NoError(err)
+	r.Equal(DefaultRateLimit-1, client.RateLimit().Limits[0].Remaining)
+
+	oldToken := client.AuthToken()
+	server.ExpireTokens()
+	_, err = client.GetApp(int64(app.Id))
+	r.NoError(err)
+	r.True(oldToken.AccessToken != client.AuthToken().AccessToken)
+
+	server.SetRateLimit(100, 0)
+	limited := podio.NewClient(server.Token(), podio.WithBaseURL(server.URL), podio.WithRateLimiter(nil), podio.WithRetryPolicy(&podio.RetryPolicy{MaxAttempts: 1}))
+	_, err = limited.GetApp(int64(app.Id))
+	r.ErrorIs(err, podio.ErrRateLimited)
+
+	server.SetRateLimitWindow(50 * time.Millisecond)
+	server.SetRateLimit(100, 0)
+	time.Sleep(60 * time.Millisecond)
+	_, err = limited.GetApp(int64(app.Id))
+	r.NoError(err)
+}
@@ -0,0 +1,260 @@
+package podio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Podio resets the rate limits every hour
+const rateLimitWindow = time.Hour
+
+// RateLimiter tracks the remaining Podio rate limit from the X-Rate-Limit-* headers of every response.
+// It slows callers down once the remaining budget drops below SlowdownRatio of the limit,
+// holds them back when nothing is left and waits out rate limit responses (420/429).
+//
+// Podio doesn't say when a window resets, so a window is assumed to start with the first
+// response of a limit and to last an hour. When nothing is left the limiter doesn't wait
+// for that estimate but lets a request through every DefaultBackoff to find out whether
+// the limit was reset already.
+//
+// Podio uses separate limits for regular and "rate limited" operations. The limiter keeps
+// track of each of them and remembers which limit the responses of a route counted against,
+// so running out of one limit doesn't hold back the requests of the other.
+// A RateLimiter is safe for concurrent use and can be shared by clients using the same token.
+type RateLimiter struct {
+	// SlowdownRatio is the fraction of the limit below which requests get spaced out (default 0.1)
+	SlowdownRatio float64
+	// MaxRetries is how many times a rate limited request is retried before the error is returned (default 3)
+	MaxRetries int
+	// DefaultBackoff is used after a rate limit response without Retry-After header and
+	// between requests once a limit is used up (default 1 minute)
+	DefaultBackoff time.Duration
+
+	mu           sync.Mutex
+	buckets      map[int]*RateLimitState
+	routes       map[string]int // the limit of the last response of a route
+	blockedUntil time.Time
+}
+
+// RateLimitState is the last known state of one Podio rate limit
+type RateLimitState struct {
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	ResetAt   time.Time `json:"reset_at"` // estimated, Podio does not send it
+	UpdatedAt time.Time `json:"updated_at"`
+	// BlockedUntil is set by a rate limit response to a request counting against this limit
+	BlockedUntil time.Time `json:"blocked_until"`
+}
+
+// RateLimiterState is a snapshot of a RateLimiter, e.g. for dashboards
+type RateLimiterState struct {
+	Limits []RateLimitState `json:"limits"`
+	// BlockedUntil holds back all requests, set by rate limit responses of routes with an unknown limit
+	BlockedUntil time.Time `json:"blocked_until"`
+}
+
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		SlowdownRatio:  0.1,
+		MaxRetries:     3,
+		DefaultBackoff: time.Minute,
+	}
+}
+
+// WithRateLimiter replaces the rate limiter of the client, nil disables rate limiting
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(client *Client) {
+		client.rateLimiter = limiter
+	}
+}
+
+// RateLimit returns the current rate limiter state of the client
+func (client *Client) RateLimit() RateLimiterState {
+	if client.rateLimiter == nil {
+		return RateLimiterState{}
+	}
+	return client.rateLimiter.State()
+}
+
+// Wait blocks until any request may be sent, applying the strictest limit, or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	return waitFor(ctx, l.delay(time.Now()))
+}
+
+// WaitRoute blocks until a request of route may be sent, applying the limit its last response
+// counted against. When the wait would pass the deadline of ctx it returns an error wrapping
+// ErrRateLimited right away instead of waiting for the deadline.
+func (l *RateLimiter) WaitRoute(ctx context.Context, route string) error {
+	return waitFor(ctx, l.routeDelay(route, time.Now()))
+}
+
+func waitFor(ctx context.Context, delay time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && delay > 0 && time.Now().Add(delay).After(deadline) {
+		return fmt.Errorf("%w: the next request is allowed in %s, after the deadline", ErrRateLimited, delay.Round(time.Second))
+	}
+	return sleep(ctx, delay)
+}
+
+func (l *RateLimiter) delay(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delay := max(l.blockedUntil.Sub(now), 0)
+	for _, bucket := range l.buckets {
+		if d := l.bucketDelay(bucket, now); d > delay {
+			delay = d
+		}
+	}
+	return delay
+}
+
+// routeDelay only applies the limit of route, requests of unknown routes are only held back by Block
+func (l *RateLimiter) routeDelay(route string, now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delay := max(l.blockedUntil.Sub(now), 0)
+	if limit, ok := l.routes[route]; ok {
+		if d := l.bucketDelay(l.buckets[limit], now); d > delay {
+			delay = d
+		}
+	}
+	return delay
+}
+
+func (l *RateLimiter) bucketDelay(bucket *RateLimitState, now time.Time) time.Duration {
+	blocked := max(bucket.BlockedUntil.Sub(now), 0)
+	untilReset := bucket.ResetAt.Sub(now)
+	if untilReset <= 0 || bucket.Limit <= 0 {
+		// the window passed, so the budget is back
+		return blocked
+	}
+	if bucket.Remaining <= 0 {
+		// the reset is only estimated, try again after a backoff to see if it happened
+		return max(min(untilReset, bucket.UpdatedAt.Add(l.backoff()).Sub(now)), blocked)
+	}
+	if blocked > 0 {
+		return blocked
+	}
+	if float64(bucket.Remaining) > l.SlowdownRatio*float64(bucket.Limit) {
+		return 0
+	}
+	// spread what is left evenly over the rest of the window
+	return untilReset / time.Duration(bucket.Remaining+1)
+}
+
+// Update records the rate limit headers of resp
+func (l *RateLimiter) Update(resp *http.Response) {
+	l.UpdateRoute("", resp)
+}
+
+// UpdateRoute records the rate limit headers of resp, a response to a request of route
+func (l *RateLimiter) UpdateRoute(route string, resp *http.Response) {
+	limit, errLimit := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Limit"))
+	remaining, errRemaining := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Remaining"))
+	if errLimit != nil || errRemaining != nil {
+		return
+	}
+
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.buckets == nil {
+		l.buckets = map[int]*RateLimitState{}
+	}
+	bucket, ok := l.buckets[limit]
+	switch {
+	case !ok:
+		l.buckets[limit] = &RateLimitState{Limit: limit, Remaining: remaining, ResetAt: now.Add(rateLimitWindow), UpdatedAt: now}
+	case !now.Before(bucket.ResetAt), bucket.Remaining <= 0 && remaining > 0 && now.Sub(bucket.UpdatedAt) >= l.backoff():
+		// a new window started: the estimated one passed, or there is budget again a
+		// backoff after the limit was used up, too late for a response sent before that
+		bucket.Remaining = remaining
+		bucket.ResetAt = now.Add(rateLimitWindow)
+		bucket.UpdatedAt = now
+	default:
+		// responses of concurrent requests arrive out of order, only the lowest
+		// remaining budget of a window counts
+		bucket.Remaining = min(bucket.Remaining, remaining)
+		bucket.UpdatedAt = now
+	}
+
+	if route != "" {
+		if l.routes == nil {
+			l.routes = map[string]int{}
+		}
+		l.routes[route] = limit
+	}
+}
+
+// Block holds back all requests for d, e.g. after a rate limit response
+func (l *RateLimiter) Block(d time.Duration) {
+	l.BlockRoute("", d)
+}
+
+// BlockRoute holds back the requests counting against the same limit as route for d,
+// e.g. after a rate limit response to a request of route. When the limit of route is
+// unknown all requests are held back.
+func (l *RateLimiter) BlockRoute(route string, d time.Duration) {
+	if d <= 0 {
+		d = l.backoff()
+	}
+	until := time.Now().Add(d)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	blockedUntil := &l.blockedUntil
+	if limit, ok := l.routes[route]; ok && route != "" {
+		blockedUntil = &l.buckets[limit].BlockedUntil
+	}
+	if until.After(*blockedUntil) {
+		*blockedUntil = until
+	}
+}
+
+func (l *RateLimiter) backoff() time.Duration {
+	if l.DefaultBackoff > 0 {
+		return l.DefaultBackoff
+	}
+	return time.Minute
+}
+
+func (l *RateLimiter) State() RateLimiterState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	state := RateLimiterState{BlockedUntil: l.blockedUntil}
+	for _, bucket := range l.buckets {
+		state.Limits = append(state.Limits, *bucket)
+	}
+	sort.Slice(state.Limits, func(i, j int) bool {
+		return state.Limits[i].Limit < state.Limits[j].Limit
+	})
+	return state
+}
+
+// Podio answers with 420 when the rate limit is exceeded, 429 is handled the same way
+func isRateLimited(statusCode int) bool {
+	return statusCode == 420 || statusCode == http.StatusTooManyRequests
+}
+
+// parseRetryAfter reads the Retry-After header, either in seconds or as http date
+func parseRetryAfter(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
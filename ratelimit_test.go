@@ -0,0 +1,139 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterDelay(t *testing.T) {
+	r := require.New(t)
+
+	limiter := NewRateLimiter()
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-Rate-Limit-Limit", "1000")
+	resp.Header.Set("X-Rate-Limit-Remaining", "500")
+	limiter.Update(resp)
+
+	now := time.Now()
+	r.Equal(time.Duration(0), limiter.delay(now))
+
+	resp.Header.Set("X-Rate-Limit-Remaining", "9")
+	limiter.Update(resp)
+	r.True(limiter.delay(now) > 5*time.Minute, "remaining budget is spread over the window")
+
+	resp.Header.Set("X-Rate-Limit-Remaining", "0")
+	limiter.Update(resp)
+	delay := limiter.delay(time.Now())
+	r.True(delay > 59*time.Second && delay <= time.Minute, "exhausted budget is probed after the backoff, not the estimated reset")
+
+	state := limiter.State()
+	r.Len(state.Limits, 1)
+	r.Equal(0, state.Limits[0].Remaining)
+}
+
+func TestRateLimiterOutOfOrderAndEarlyReset(t *testing.T) {
+	r := require.New(t)
+
+	limiter := NewRateLimiter()
+	limiter.DefaultBackoff = 20 * time.Millisecond
+	update := func(remaining string) {
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set("X-Rate-Limit-Limit", "1000")
+		resp.Header.Set("X-Rate-Limit-Remaining", remaining)
+		limiter.Update(resp)
+	}
+
+	update("10")
+	update("0")
+	update("7") // a late response of a request sent before the limit ran out
+	r.Equal(0, limiter.State().Limits[0].Remaining)
+	resetAt := limiter.State().Limits[0].ResetAt
+
+	time.Sleep(30 * time.Millisecond)
+	r.Equal(time.Duration(0), limiter.delay(time.Now()), "the used up limit is probed after the backoff")
+	update("999")
+	state := limiter.State().Limits[0]
+	r.Equal(999, state.Remaining)
+	r.True(state.ResetAt.After(resetAt), "a new window started")
+}
+
+func TestRateLimiterBlockRoute(t *testing.T) {
+	r := require.New(t)
+
+	limiter := NewRateLimiter()
+	for route, limit := range map[string]string{"POST /item/app/{id}/filter": "1000", "GET /item/{id}": "5000"} {
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set("X-Rate-Limit-Limit", limit)
+		resp.Header.Set("X-Rate-Limit-Remaining", "900")
+		limiter.UpdateRoute(route, resp)
+	}
+
+	limiter.BlockRoute("POST /item/app/{id}/filter", time.Minute)
+	now := time.Now()
+	r.True(limiter.routeDelay("POST /item/app/{id}/filter", now) > 59*time.Second)
+	r.Equal(time.Duration(0), limiter.routeDelay("GET /item/{id}", now), "other limits are not held back")
+	r.True(limiter.State().BlockedUntil.IsZero())
+
+	limiter.BlockRoute("GET /app/{id}", time.Minute)
+	r.True(limiter.routeDelay("GET /item/{id}", now) > 59*time.Second, "unknown routes hold back everything")
+}
+
+func TestRateLimitedResponseIsRetried(t *testing.T) {
+	r := require.New(t)
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(420)
+			w.Write([]byte(`{"error": "rate_limit", "error_description": "You have hit the rate limit"}`))
+			return
+		}
+		w.Header().Set("X-Rate-Limit-Limit", "5000")
+		w.Header().Set("X-Rate-Limit-Remaining", "4999")
+		w.Write([]byte(`{"user_id": 1}`))
+	}))
+	defer server.Close()
+
+	limiter := NewRateLimiter()
+	limiter.DefaultBackoff = 10 * time.Millisecond
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL), WithRateLimiter(limiter))
+
+	user, err := client.GetUser()
+	r.NoError(err)
+	r.Equal(UserID(1), user.Id)
+	r.Equal(2, calls)
+	r.Equal(4999, client.RateLimit().Limits[0].Remaining)
+}
+
+func TestRateLimiterRouteBuckets(t *testing.T) {
+	r := require.New(t)
+
+	limiter := NewRateLimiter()
+	limited := &http.Response{Header: http.Header{}}
+	limited.Header.Set("X-Rate-Limit-Limit", "1000")
+	limited.Header.Set("X-Rate-Limit-Remaining", "0")
+	limiter.UpdateRoute("POST /item/app/{id}/filter", limited)
+	regular := &http.Response{Header: http.Header{}}
+	regular.Header.Set("X-Rate-Limit-Limit", "5000")
+	regular.Header.Set("X-Rate-Limit-Remaining", "4000")
+	limiter.UpdateRoute("GET /item/{id}", regular)
+
+	now := time.Now()
+	r.True(limiter.routeDelay("POST /item/app/{id}/filter", now) > 59*time.Second)
+	r.Equal(time.Duration(0), limiter.routeDelay("GET /item/{id}", now))
+	r.Equal(time.Duration(0), limiter.routeDelay("GET /app/{id}", now), "unknown routes are not held back")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	start := time.Now()
+	err := limiter.WaitRoute(ctx, "POST /item/app/{id}/filter")
+	r.ErrorIs(err, ErrRateLimited)
+	r.True(time.Since(start) < time.Second, "a wait past the deadline fails right away")
+	r.NoError(limiter.WaitRoute(ctx, "GET /item/{id}"))
+}
@@ -0,0 +1,79 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// Response holds the metadata of the last HTTP response of a call. Pass it
+// with CaptureResponse to any ...Ctx method:
+//
+//	var resp podio.Response
+//	err := client.UpdateItemWithParamsCtx(podio.CaptureResponse(ctx, &resp), itemId, params, nil)
+//	fmt.Println(resp.StatusCode, resp.RateLimitRemaining)
+type Response struct {
+	StatusCode         int
+	RateLimit          int
+	RateLimitRemaining int
+	Header             http.Header
+
+	// Body is the raw response body, only kept when captured with CaptureResponseWithBody
+	Body []byte
+}
+
+type responseCapture struct {
+	// mu guards resp, the ctx can be shared by concurrent calls, e.g. a paginator with Prefetch
+	mu       sync.Mutex
+	resp     *Response
+	withBody bool
+	parent   *responseCapture
+}
+
+type responseCaptureKey struct{}
+
+// CaptureResponse returns a context that makes calls store their response metadata in resp.
+// It is also filled for error responses, as long as Podio answered. Calls running at the same
+// time with ctx, e.g. an iterator with Prefetch, fill resp one after another, so read it only
+// once they returned.
+func CaptureResponse(ctx context.Context, resp *Response) context.Context {
+	return captureResponse(ctx, resp, false)
+}
+
+// CaptureResponseWithBody is like CaptureResponse but also keeps the raw body,
+// e.g. to store the JSON as returned by Podio next to the decoded value.
+func CaptureResponseWithBody(ctx context.Context, resp *Response) context.Context {
+	return captureResponse(ctx, resp, true)
+}
+
+func captureResponse(ctx context.Context, resp *Response, withBody bool) context.Context {
+	parent, _ := ctx.Value(responseCaptureKey{}).(*responseCapture)
+	return context.WithValue(ctx, responseCaptureKey{}, &responseCapture{resp: resp, withBody: withBody, parent: parent})
+}
+
+func newResponse(resp *http.Response, respBody []byte) *Response {
+	limit, _ := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Limit"))
+	remaining, _ := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Remaining"))
+	return &Response{
+		StatusCode:         resp.StatusCode,
+		RateLimit:          limit,
+		RateLimitRemaining: remaining,
+		Header:             resp.Header,
+		Body:               respBody,
+	}
+}
+
+// fillCapturedResponses copies response into every Response registered on ctx
+func fillCapturedResponses(ctx context.Context, response *Response) {
+	capture, _ := ctx.Value(responseCaptureKey{}).(*responseCapture)
+	for ; capture != nil; capture = capture.parent {
+		captured := *response
+		if !capture.withBody {
+			captured.Body = nil
+		}
+		capture.mu.Lock()
+		*capture.resp = captured
+		capture.mu.Unlock()
+	}
+}
@@ -0,0 +1,65 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptureResponse(t *testing.T) {
+	r := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-Rate-Limit-Limit", "5000")
+		w.Header().Set("X-Rate-Limit-Remaining", "4321")
+		w.Write([]byte(`{"item_id": 12, "title": "a"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+	var outer, inner Response
+	ctx := CaptureResponse(context.Background(), &outer)
+	item, err := client.GetItemSimpleCtx(CaptureResponseWithBody(ctx, &inner), 12)
+	r.NoError(err)
+	r.Equal(ItemID(12), item.Id)
+
+	r.Equal(200, inner.StatusCode)
+	r.Equal(5000, inner.RateLimit)
+	r.Equal(4321, inner.RateLimitRemaining)
+	r.Equal(`{"item_id": 12, "title": "a"}`, string(inner.Body))
+
+	r.Equal(200, outer.StatusCode)
+	r.Nil(outer.Body)
+
+	statusCode, err := client.UpdateItemWithParamsAndStatusCode(12, nil, nil)
+	r.NoError(err)
+	r.Equal(200, statusCode)
+}
+
+func TestCaptureResponseConcurrentCalls(t *testing.T) {
+	r := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"item_id": 12}`))
+	}))
+	defer server.Close()
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+	var resp Response
+	ctx := CaptureResponse(context.Background(), &resp)
+	errs := make(chan error)
+	for i := 0; i < 10; i++ {
+		go func() {
+			_, err := client.GetItemSimpleCtx(ctx, 12)
+			errs <- err
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		r.NoError(<-errs)
+	}
+	r.Equal(200, resp.StatusCode)
+}
@@ -0,0 +1,131 @@
+package podio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy decides which failed requests are sent again.
+// Transient network errors (timeouts, refused or reset connections, responses cut short) and
+// 5xx responses are retried for requests that only read: the safe methods (GET, HEAD, OPTIONS)
+// and item filters, which are POST requests. Other requests are only retried when
+// RetryUnsafeMethods is set or the context was marked with AllowRetry.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one (1 disables retries)
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry, it doubles with every attempt
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff, 0 means no cap (Retry-After headers are honored even when longer)
+	MaxDelay time.Duration
+	// RetryUnsafeMethods also retries POST, PUT and DELETE requests
+	RetryUnsafeMethods bool
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+// WithRetryPolicy replaces the retry policy of the client, nil disables retries
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(client *Client) {
+		client.retryPolicy = policy
+	}
+}
+
+type allowRetryKey struct{}
+
+// AllowRetry marks requests made with the returned context as safe to retry,
+// use it for POST/PUT/DELETE calls that can be repeated without side effects.
+func AllowRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allowRetryKey{}, true)
+}
+
+// backoff returns how long to wait before the next attempt and whether there should be one at all.
+// attempt is the number of attempts made so far.
+func (p *RetryPolicy) backoff(ctx context.Context, method string, path string, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+	if err != nil && !isNetworkError(err) {
+		return 0, false
+	}
+	if err == nil && !isRetryableStatus(resp.StatusCode) {
+		return 0, false
+	}
+	if !p.RetryUnsafeMethods && !isSafeMethod(method) && !isFilterRequest(method, path) && ctx.Value(allowRetryKey{}) == nil {
+		return 0, false
+	}
+
+	if resp != nil {
+		if retryAfter := parseRetryAfter(resp.Header); retryAfter > 0 {
+			return retryAfter, true
+		}
+	}
+
+	// exponential backoff with full jitter
+	delay := p.BaseDelay << uint(attempt-1)
+	if delay <= 0 && p.BaseDelay > 0 {
+		// the shift overflowed
+		delay = math.MaxInt64
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0, true
+	}
+	return time.Duration(rand.Int63n(int64(delay))), true
+}
+
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isNetworkError reports transient transport failures: timeouts, refused or reset connections
+// and responses cut short. Transports can decide themselves with errors that have a Retryable method.
+func isNetworkError(err error) bool {
+	var retryable interface{ Retryable() bool }
+	if errors.As(err, &retryable) {
+		return retryable.Retryable()
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isSafeMethod(method string) bool {
+	return method == "GET" || method == "HEAD" || method == "OPTIONS"
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
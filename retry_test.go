@@ -0,0 +1,90 @@
+package podio
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTransientFailures(t *testing.T) {
+	r := require.New(t)
+
+	calls := map[string]int{}
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls[req.Method]++
+		body, _ := ioutil.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+		if calls[req.Method] == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("<html><h1>502 Bad Gateway</h1></html>"))
+			return
+		}
+		w.Write([]byte(`{"item_id": 1}`))
+	}))
+	defer server.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL), WithRetryPolicy(policy))
+
+	_, err := client.GetItem(1)
+	r.NoError(err)
+	r.Equal(2, calls["GET"])
+
+	// POST is only retried when the caller opts in
+	_, err = client.CreateItem(1, "", map[string]interface{}{"title": "a"})
+	r.Error(err)
+	r.Equal(1, calls["POST"])
+
+	calls["POST"] = 0
+	bodies = nil
+	_, err = client.CreateItemCtx(AllowRetry(context.Background()), 1, "", map[string]interface{}{"title": "a"})
+	r.NoError(err)
+	r.Equal(2, calls["POST"])
+	r.Equal(bodies[0], bodies[1], "body is replayed")
+}
+
+func TestIsNetworkError(t *testing.T) {
+	r := require.New(t)
+
+	r.True(isNetworkError(&url.Error{Op: "Get", URL: "/item/1", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}))
+	r.True(isNetworkError(&url.Error{Op: "Get", URL: "/item/1", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}))
+	r.True(isNetworkError(&url.Error{Op: "Get", URL: "/item/1", Err: io.ErrUnexpectedEOF}))
+	r.True(isNetworkError(&url.Error{Op: "Get", URL: "/item/1", Err: context.DeadlineExceeded}))
+	r.False(isNetworkError(&url.Error{Op: "Get", URL: "/item/1", Err: errors.New("unsupported protocol scheme")}))
+	r.False(isNetworkError(&url.Error{Op: "Get", URL: "/item/1", Err: x509.UnknownAuthorityError{}}))
+	r.False(isNetworkError(&url.Error{Op: "Get", URL: "/item/1", Err: context.Canceled}))
+}
+
+func TestRetryBackoffWithoutMaxDelay(t *testing.T) {
+	r := require.New(t)
+
+	policy := &RetryPolicy{MaxAttempts: 4, BaseDelay: time.Second}
+	total := time.Duration(0)
+	for attempt := 1; attempt < 4; attempt++ {
+		delay, retry := policy.backoff(context.Background(), "GET", "/item/1", attempt, nil, io.ErrUnexpectedEOF)
+		r.True(retry)
+		r.True(delay < time.Second<<uint(attempt-1))
+		total += delay
+	}
+	r.True(total > 0, "retries must not fire back to back")
+
+	// item filters only read, so they are retried like GET requests
+	_, retry := policy.backoff(context.Background(), "POST", "/item/app/1/filter/?fields=items", 1, nil, io.ErrUnexpectedEOF)
+	r.True(retry)
+	_, retry = policy.backoff(context.Background(), "POST", "/item/app/1/", 1, nil, io.ErrUnexpectedEOF)
+	r.False(retry)
+}
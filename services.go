@@ -0,0 +1,1092 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+)
+
+// The services group the API of the Client by topic, so code depending on this
+// package can accept e.g. an ItemService and tests can pass a mock from podiomock.
+// Their methods are the context-aware methods of the Client without the Ctx suffix,
+// deprecated methods are left out.
+
+// AppService groups the API calls for apps, app fields and forms, get it with Client.Apps
+type AppService interface {
+	// https://developers.podio.com/doc/applications/get-apps-by-space-22478
+	GetApps(ctx context.Context, spaceId SpaceID, options map[string]interface{}) (apps []*App, err error)
+
+	// https://developers.podio.com/doc/applications/get-app-22349
+	GetApp(ctx context.Context, id AppID) (app *App, err error)
+
+	// https://developers.podio.com/doc/applications/get-app-on-space-by-url-label-477105
+	GetAppBySpaceIdAndSlug(ctx context.Context, spaceId SpaceID, slug string) (app *App, err error)
+
+	// https://developers.podio.com/doc/applications/get-space-app-dependencies-45779
+	GetSpaceDependencies(ctx context.Context, spaceId SpaceID) (response *interface{}, err error)
+
+	// https://developers.podio.com/doc/applications/add-new-app-22351
+	CreateApp(ctx context.Context, spaceId SpaceID, config map[string]interface{}, fields []AppField) (AppId AppID, err error)
+
+	// https://developers.podio.com/doc/applications/update-app-22352
+	UpdateApp(ctx context.Context, appId AppID, config map[string]interface{}) (err error)
+
+	// https://developers.podio.com/doc/applications/update-app-22352
+	UpdateAppRaw(ctx context.Context, appId AppID, configRaw json.RawMessage) (err error)
+
+	// https://developers.podio.com/doc/applications/install-app-22506
+	InstallApp(ctx context.Context, appId AppID, spaceId SpaceID, features []string) (AppId AppID, err error)
+
+	// https://developers.podio.com/doc/applications/add-new-app-field-22354
+	CreateAppField(ctx context.Context, appId AppID, params map[string]interface{}) (AppFieldId FieldID, err error)
+
+	// https://developers.podio.com/doc/applications/add-new-app-field-22354
+	CreateAppFieldRawConfig(ctx context.Context, appId AppID, config json.RawMessage) (AppFieldId FieldID, err error)
+
+	// https://developers.podio.com/doc/applications/update-an-app-field-22356
+	UpdateAppField(ctx context.Context, appId AppID, appFieldId FieldID, params map[string]interface{}) (revision int, err error)
+
+	// https://developers.podio.com/doc/applications/update-an-app-field-22356
+	UpdateAppFieldRawConfig(ctx context.Context, appId AppID, appFieldId FieldID, config json.RawMessage) (int, error)
+
+	// https://developers.podio.com/doc/items/get-field-ranges-24242866
+	GetFieldRange(ctx context.Context, fieldID FieldID) (FieldRange, error)
+
+	// https://developers.podio.com/doc/forms/get-forms-53771
+	GetForms(ctx context.Context, appId AppID) (forms []*Form, err error)
+}
+
+// BatchService groups the API calls for batches, get it with Client.Batches
+type BatchService interface {
+	// https://developers.podio.com/doc/batch/get-batch-6144225
+	GetBatch(ctx context.Context, batchId int64) (batch *Batch, err error)
+}
+
+// CommentService groups the API calls for comments, get it with Client.Comments
+type CommentService interface {
+	// Comment adds a comment to a podio object. It returns a Comment (with podio ID) or an error if one occured.
+	//
+	// refType (item, task, ...) and refId identifies the podio object to which the comment is added.
+	// text is the actual comment value.
+	// Additional parameters can be set in the params map.
+	Comment(ctx context.Context, refType string, refId int64, text string, params map[string]interface{}) (*Comment, error)
+
+	// UpdateComment updates a comment in Podio
+	UpdateComment(ctx context.Context, commentID int64, text string, params map[string]interface{}) error
+
+	// DeleteComment deletes a comment in Podio
+	DeleteComment(ctx context.Context, commentID int64) error
+
+	// https://developers.podio.com/doc/comments/get-comments-on-object-22371
+	// GetComments retrieves the comments associated with a podio object.
+	//
+	// refType is the type of the podio object. For legal type values see
+	// refId is the podio id of the podio object.
+	GetComments(ctx context.Context, refType string, refId int64) (comments []*Comment, err error)
+
+	// https://developers.podio.com/doc/comments/get-a-comment-22345
+	GetComment(ctx context.Context, commentId int64) (comment *Comment, err error)
+}
+
+// ContactService groups the API calls for contacts, get it with Client.Contacts
+type ContactService interface {
+	// https://developers.podio.com/doc/contacts/get-contacts-22400
+	GetContacts(ctx context.Context, limit, offset int) (contacts []Contact, err error)
+
+	// https://developers.podio.com/doc/contacts/get-user-contact-60514
+	GetContact(ctx context.Context, userId UserID) (contact Contact, err error)
+}
+
+// ConversationService groups the API calls for conversations, get it with Client.Conversations
+type ConversationService interface {
+	// https://developers.podio.com/doc/conversations/create-conversation-v2-37301474
+	CreateConversation(ctx context.Context, params map[string]interface{}) (c Conversation, err error)
+
+	// https://developers.podio.com/doc/conversations/add-participants-v2-37282400
+	ConversationAddParticipants(ctx context.Context, conversationId int64, participants []interface{}) (err error)
+}
+
+// EmbedService groups the API calls for embeds, get it with Client.Embeds
+type EmbedService interface {
+	// https://developers.podio.com/doc/embeds/add-an-embed-726483
+	CreateEmbed(ctx context.Context, params map[string]interface{}) (embed *Embed, err error)
+}
+
+// FileService groups the API calls for files, get it with Client.Files
+type FileService interface {
+	// https://developers.podio.com/doc/files/get-files-4497983
+	GetFiles(ctx context.Context) (files []File, err error)
+
+	// https://developers.podio.com/doc/files/get-file-22451
+	GetFile(ctx context.Context, fileId FileID) (file *File, err error)
+
+	GetFileContents(ctx context.Context, url string) ([]byte, error)
+
+	GetFileContentsToTempFile(ctx context.Context, url string) (tempFilePath, fileName, mimeType string, close func(), err error)
+
+	FileAndHeaders(ctx context.Context, url string) (tempFilePath string, headers map[string]string, close func(), err error)
+
+	// https://developers.podio.com/doc/files/upload-file-1004361
+	CreateFile(ctx context.Context, name string, contents []byte) (file *File, err error)
+
+	// https://developers.podio.com/doc/files/replace-file-22450
+	ReplaceFile(ctx context.Context, oldFileId, newFileId FileID) error
+
+	// https://developers.podio.com/doc/files/attach-file-22518
+	AttachFile(ctx context.Context, fileId FileID, refType string, refId int64) error
+
+	// https://developers.podio.com/doc/files/delete-file-22453
+	DeleteFile(ctx context.Context, fileId FileID) error
+
+	// https://developers.podio.com/doc/files/copy-file-89977
+	CopyFile(ctx context.Context, fileId FileID) (FileID, error)
+
+	// https://developers.podio.com/doc/files/get-files-on-space-22471
+	FindFilesForSpace(ctx context.Context, spaceId SpaceID, params map[string]interface{}) (files []*File, err error)
+
+	// https://developers.podio.com/doc/files/get-files-on-app-22472
+	FindFilesForApp(ctx context.Context, appId AppID, params map[string]interface{}) (files []*File, err error)
+
+	// https://developers.podio.com/doc/files/update-file-22454
+	UpdateFile(ctx context.Context, fileId FileID, description string) (err error)
+}
+
+// GrantService groups the API calls for grants, get it with Client.Grants
+type GrantService interface {
+	// https://developers.podio.com/doc/grants/create-grant-16168841
+	CreateGrant(ctx context.Context, refType string, refId int64, params map[string]interface{}) (g GrantResponse, err error)
+}
+
+// HookService groups the API calls for hooks, get it with Client.Hooks
+type HookService interface {
+	// https://developers.podio.com/doc/hooks/create-hook-215056
+	CreateHook(ctx context.Context, refType string, refId int64, url string, hookType string) (hook Hook, err error)
+
+	// https://developers.podio.com/doc/hooks/request-hook-verification-215232
+	VerifyHook(ctx context.Context, hookId int64) error
+
+	// https://developers.podio.com/doc/hooks/validate-hook-verification-215241
+	ValidateHook(ctx context.Context, hookId int64, code string) error
+
+	// https://developers.podio.com/doc/hooks/delete-hook-215291
+	DeleteHook(ctx context.Context, hookId int64) error
+
+	// https://developers.podio.com/doc/hooks/get-hooks-215285
+	FindHooks(ctx context.Context, refType string, refId int64) (hooks []Hook, err error)
+}
+
+// ItemService groups the API calls for items, item revisions and imports, get it with Client.Items
+type ItemService interface {
+	// https://developers.podio.com/doc/items/filter-items-4496747
+	GetItems(ctx context.Context, appId AppID) (items *ItemList, err error)
+
+	// https://developers.podio.com/doc/items/filter-items-4496747
+	GetItemsSimple(ctx context.Context, appId AppID) (items *ItemListSimple, err error)
+
+	// https://developers.podio.com/doc/items/filter-items-4496747
+	FilterItems(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemList, err error)
+
+	// https://developers.podio.com/doc/items/filter-items-4496747
+	FilterItemsSimple(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemListSimple, err error)
+
+	// https://developers.podio.com/doc/items/filter-items-4496747
+	// fields is a raw selector, FilterItemsSimpleCtx with WithFields builds it instead.
+	FilterItemsSimpleWithCustomFields(ctx context.Context, appId AppID, params map[string]interface{}, fields string) (items *ItemListSimple, err error)
+
+	// https://developers.podio.com/doc/items/filter-items-4496747
+	FilterItemsMicro(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemListMicro, err error)
+
+	// https://developers.podio.com/doc/items/filter-items-4496747
+	FilterItemsMini(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemListMini, err error)
+
+	// https://developers.podio.com/doc/items/export-items-4235696
+	ExportItems(ctx context.Context, appId AppID, exportFormat string, params map[string]interface{}) (int64, error)
+
+	// https://developers.podio.com/doc/items/get-item-by-app-item-id-66506688
+	GetItemByAppItemId(ctx context.Context, appId AppID, formattedAppItemId string) (item *Item, err error)
+
+	// https://developers.podio.com/doc/items/get-item-by-app-item-id-66506688
+	GetItemSimpleByAppItemId(ctx context.Context, appId AppID, formattedAppItemId string) (item *ItemSimple, err error)
+
+	// https://developers.podio.com/doc/items/get-item-by-external-id-19556702
+	GetItemByExternalID(ctx context.Context, appId AppID, externalId string) (item *Item, err error)
+
+	// https://developers.podio.com/doc/items/get-item-22360
+	GetItem(ctx context.Context, itemId ItemID) (item *Item, err error)
+
+	// get item (and more specifically app fields) in the format Elsa Understands
+	// https://developers.podio.com/doc/items/get-item-22360
+	GetItemSimple(ctx context.Context, itemId ItemID) (item *ItemSimple, err error)
+
+	// https://developers.podio.com/doc/items/get-item-by-external-id-19556702
+	GetItemSimpleByExternalID(ctx context.Context, appId AppID, externalId string) (item *ItemSimple, err error)
+
+	// get item with only micro attributes (FYI: there is no way to get a trimmed version from the API, but at least we don't parse all the values)
+	// https://developers.podio.com/doc/items/get-item-22360
+	GetItemMicro(ctx context.Context, itemId ItemID) (item *ItemMicro, err error)
+
+	// https://developers.podio.com/doc/items/add-new-item-22362
+	CreateItem(ctx context.Context, appId AppID, externalId string, fieldValues map[string]interface{}) (ItemID, error)
+
+	// https://developers.podio.com/doc/items/add-new-item-22362
+	CreateItemThroughParams(ctx context.Context, appId AppID, params, options map[string]interface{}) (item *ItemSimple, err error)
+
+	// https://developers.podio.com/doc/items/update-item-22363
+	UpdateItem(ctx context.Context, itemId ItemID, fieldValues map[string]interface{}) error
+
+	// https://developers.podio.com/doc/items/update-item-22363
+	UpdateItemWithParams(ctx context.Context, itemId ItemID, params, options map[string]interface{}) (err error)
+
+	// https://developers.podio.com/doc/items/get-item-count-34819997
+	ItemCount(ctx context.Context, appId AppID, options map[string]interface{}) (count ItemCount, err error)
+
+	// https://developers.podio.com/doc/items/find-referenceable-items-22485
+	ItemSearchField(ctx context.Context, AppFieldId FieldID, options map[string]interface{}) (items []Item, err error)
+
+	// https://developers.podio.com/doc/items/clone-item-37722742
+	ItemClone(ctx context.Context, itemID ItemID, options map[string]interface{}) (clonedItemID ItemID, err error)
+
+	// https://developers.podio.com/doc/items/bulk-delete-items-19406111
+	// todo later parse the response (deleted / pending item ids)
+	ItemBulkDelete(ctx context.Context, appID AppID, params map[string]interface{}) (err error)
+
+	// https://developers.podio.com/doc/items/delete-item-22364
+	ItemDelete(ctx context.Context, itemID ItemID, params map[string]interface{}) (err error)
+
+	// https://developers.podio.com/doc/items/get-item-references-22439
+	GetItemReferences(ctx context.Context, itemID ItemID) (references []*ItemReferences, err error)
+
+	// https://developers.podio.com/doc/items/get-references-to-item-by-field-7403920
+	GetItemReferencesByField(ctx context.Context, itemID ItemID, appFieldID FieldID) (references []*ItemMicro, err error)
+
+	// IterateItems pages through all items matching params, see
+	// https://developers.podio.com/doc/items/filter-items-4496747
+	//
+	// The limit in params is replaced by the page size, an offset in params works like
+	// FromOffset. Paging stops once Filtered items are read. Every page is a regular
+	// request, so it waits for the rate limiter and stops with the error of a cancelled context.
+	//
+	//	for item, err := range client.IterateItems(appId, params) {
+	//		if err != nil {
+	//			return err
+	//		}
+	//		// ...
+	//	}
+	IterateItems(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*Item, error]
+
+	// IterateItemsSimple is IterateItems for FilterItemsSimple.
+	IterateItemsSimple(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemSimple, error]
+
+	// IterateItemsMicro is IterateItems for FilterItemsMicro.
+	IterateItemsMicro(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemMicro, error]
+
+	// IterateItemsMini is IterateItems for FilterItemsMini.
+	IterateItemsMini(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemMini, error]
+
+	// CloneItemWithValues clones an item and sets values on the clone, see
+	// https://developers.podio.com/doc/items/clone-item-37722742
+	// When the values can't be set the clone is deleted again and the error returned. If deleting
+	// it fails as well, the error says so and the id of the clone is returned with it.
+	CloneItemWithValues(ctx context.Context, itemId ItemID, values *ItemValues, options map[string]interface{}) (clonedItemId ItemID, err error)
+
+	// https://developers.podio.com/doc/items/revert-to-revision-194362682
+	RevertToRevision(ctx context.Context, ItemId ItemID, revisionId int) (rawResponse *json.RawMessage, err error)
+
+	// https://developers.podio.com/doc/items/get-item-revisions-22372
+	RevisionsByItemId(ctx context.Context, ItemId ItemID) (revisions []ItemRevision, err error)
+
+	// https://developers.podio.com/doc/importer/import-app-items-212899
+	Importer(ctx context.Context, appId AppID, fileId FileID, params map[string]interface{}) (batchID int64, err error)
+}
+
+// NotificationService groups the API calls for notifications, get it with Client.Notifications
+type NotificationService interface {
+	// https://developers.podio.com/doc/notifications/mark-notifications-as-viewed-by-ref-553653
+	NotificationMarkAsViewedForRef(ctx context.Context, refType string, refId int64) (statusCode int, err error)
+}
+
+// OrgService groups the API calls for organizations, get it with Client.Orgs
+type OrgService interface {
+	// https://developers.podio.com/doc/organizations/get-organizations-22344
+	GetOrganizations(ctx context.Context) (orgs []Organization, err error)
+
+	// https://developers.podio.com/doc/organizations/get-organization-22383
+	GetOrganization(ctx context.Context, id int64) (org *Organization, err error)
+
+	// https://developers.podio.com/doc/organizations/get-organization-by-url-22384
+	GetOrganizationBySlug(ctx context.Context, slug string) (org *Organization, err error)
+}
+
+// SpaceService groups the API calls for spaces and space members, get it with Client.Spaces
+type SpaceService interface {
+	GetSpaces(ctx context.Context, orgId int64) (spaces []Space, err error)
+
+	GetSpace(ctx context.Context, id SpaceID) (space *Space, err error)
+
+	GetSpaceByOrgIdAndSlug(ctx context.Context, orgId int64, slug string) (space *Space, err error)
+
+	// https://developers.podio.com/doc/spaces/create-space-22390
+	CreateSpace(ctx context.Context, orgId int64, name string) (spaceId SpaceID, spaceUrl string, err error)
+
+	// https://developers.podio.com/doc/spaces/update-space-22391
+	UpdateSpace(ctx context.Context, spaceId SpaceID, name string) (err error)
+
+	// https://developers.podio.com/doc/spaces/update-space-22391
+	UpdateSpaceUrlLabel(ctx context.Context, spaceId SpaceID, urlLabel string) (err error)
+
+	// https://developers.podio.com/doc/space-members/get-space-members-v2-19350328
+	FindAllForSpace(ctx context.Context, id SpaceID, options map[string]interface{}) (spaceMembers []SpaceMember, err error)
+
+	// https://developers.podio.com/doc/space-members/get-members-of-space-22395
+	FindAllForSpaceV1(ctx context.Context, id int64, options map[string]interface{}) (spaceMembers []SpaceMemberV1, err error)
+
+	// https://developers.podio.com/doc/space-members/add-member-to-space-1066259
+	AddMember(ctx context.Context, id SpaceID, params map[string]interface{}) error
+}
+
+// StatusService groups the API calls for status messages, get it with Client.Statuses
+type StatusService interface {
+	// https://developers.podio.com/doc/status/add-new-status-message-22336
+	StatusCreate(ctx context.Context, spaceId SpaceID, params map[string]interface{}) (s Status, err error)
+
+	// https://developers.podio.com/doc/status/update-a-status-message-22338
+	StatusUpdate(ctx context.Context, statusID int64, params map[string]interface{}) error
+
+	// https://developers.podio.com/doc/status/delete-a-status-message-22339
+	StatusDelete(ctx context.Context, statusID int64) error
+}
+
+// StreamService groups the API calls for the stream, get it with Client.Streams
+type StreamService interface {
+	// https://developers.podio.com/doc/stream/get-space-stream-v3-116373969
+	StreamForSpaceV3(ctx context.Context, spaceId SpaceID, params map[string]interface{}) (s []Stream, err error)
+
+	// https://developers.podio.com/doc/stream/get-application-stream-v3-100406563
+	StreamForAppV3References(ctx context.Context, appId AppID, params map[string]interface{}) (s []StreamReference, err error)
+}
+
+// SubscriptionService groups the API calls for subscriptions, get it with Client.Subscriptions
+type SubscriptionService interface {
+	// https://developers.podio.com/doc/subscriptions/unsubscribe-by-reference-22410
+	DeleteSubscription(ctx context.Context, refType string, refId int64) error
+}
+
+// TagService groups the API calls for tags, get it with Client.Tags
+type TagService interface {
+	// https://developers.podio.com/doc/tags/create-tags-22464
+	CreateTags(ctx context.Context, refType string, refId int64, tags []string) (err error)
+
+	// https://developers.podio.com/doc/tags/update-tags-39859
+	UpdateTags(ctx context.Context, refType string, refId int64, tags []string) (err error)
+
+	// https://developers.podio.com/doc/tags/get-tags-on-app-top-68485
+	ListTopTagsForApp2(ctx context.Context, appId AppID, query string, limit int) (tags []string, err error)
+
+	// https://developers.podio.com/doc/tags/get-tags-on-app-22467
+	ListTagsForApp(ctx context.Context, appId AppID, query string, limit int) (tags []*Tag, err error)
+
+	// https://developers.podio.com/doc/tags/get-objects-on-app-with-tag-22469
+	ObjectsOnAppWithTag(ctx context.Context, appId AppID, tag string) (tags []*TaggedObject, err error)
+
+	// https://developers.podio.com/doc/tags/remove-tag-22465
+	DeleteTag(ctx context.Context, refType string, refId int64, text string) (err error)
+}
+
+// TaskService groups the API calls for tasks, get it with Client.Tasks
+type TaskService interface {
+	// https://developers.podio.com/doc/tasks/get-task-22413
+	GetTask(ctx context.Context, taskID int64) (task Task, err error)
+
+	// https://developers.podio.com/doc/tasks/get-tasks-77949
+	GetTasks(ctx context.Context, params map[string]interface{}) (tasks []Task, err error)
+
+	// https://developers.podio.com/doc/tasks/get-task-count-38316458
+	GetTaskCount(ctx context.Context, refType string, refId int64) (count TaskCount, err error)
+
+	// https://developers.podio.com/doc/tasks/create-task-22419
+	CreateTask(ctx context.Context, appId AppID, params, options map[string]interface{}) (task *Task, err error)
+}
+
+// UserService groups the API calls for the authenticated user, get it with Client.Users
+type UserService interface {
+	// GetUser gets account information for current connected user
+	// https://developers.podio.com/doc/users/get-user-22378
+	GetUser(ctx context.Context) (user User, err error)
+
+	// GetUserStatus gets account as well as profile information for current connected user
+	// https://developers.podio.com/doc/users/get-user-status-22480
+	GetUserStatus(ctx context.Context) (user UserStatus, err error)
+}
+
+// ViewService groups the API calls for views, get it with Client.Views
+type ViewService interface {
+	// https://developers.podio.com/doc/views/get-view-27450
+	GetView(ctx context.Context, appID AppID, viewIdOrName interface{}) (v View, err error)
+
+	// https://developers.podio.com/doc/views/get-views-27460
+	GetViews(ctx context.Context, appID AppID) (v []ViewFromList, err error)
+
+	// https://developers.podio.com/doc/views/get-views-27460
+	CreateViewWithParams(ctx context.Context, appID AppID, params, options map[string]interface{}) (id int64, err error)
+
+	// https://developers.podio.com/doc/views/update-view-20069949
+	UpdateViewWithParams(ctx context.Context, viewID int64, params map[string]interface{}) (err error)
+
+	// https://developers.podio.com/doc/views/delete-view-27454
+	DeleteView(ctx context.Context, viewID int64) error
+}
+
+// WidgetService groups the API calls for widgets, get it with Client.Widgets
+type WidgetService interface {
+	// https://developers.podio.com/doc/widgets/get-widget-22489
+	GetWidget(ctx context.Context, widgetID int64) (w Widget, err error)
+
+	// https://developers.podio.com/doc/widgets/get-widgets-22494
+	GetWidgets(ctx context.Context, refType string, refID int64) (w []Widget, err error)
+
+	// https://developers.podio.com/doc/widgets/delete-widget-22492
+	DeleteWidget(ctx context.Context, widgetID int64) (err error)
+
+	// https://developers.podio.com/doc/widgets/create-widget-22491
+	CreateWidget(ctx context.Context, refType string, refID int64, params map[string]interface{}) (id int64, err error)
+}
+
+func (client *Client) Apps() AppService {
+	return appService{client}
+}
+
+func (client *Client) Batches() BatchService {
+	return batchService{client}
+}
+
+func (client *Client) Comments() CommentService {
+	return commentService{client}
+}
+
+func (client *Client) Contacts() ContactService {
+	return contactService{client}
+}
+
+func (client *Client) Conversations() ConversationService {
+	return conversationService{client}
+}
+
+func (client *Client) Embeds() EmbedService {
+	return embedService{client}
+}
+
+func (client *Client) Files() FileService {
+	return fileService{client}
+}
+
+func (client *Client) Grants() GrantService {
+	return grantService{client}
+}
+
+func (client *Client) Hooks() HookService {
+	return hookService{client}
+}
+
+func (client *Client) Items() ItemService {
+	return itemService{client}
+}
+
+func (client *Client) Notifications() NotificationService {
+	return notificationService{client}
+}
+
+func (client *Client) Orgs() OrgService {
+	return orgService{client}
+}
+
+func (client *Client) Spaces() SpaceService {
+	return spaceService{client}
+}
+
+func (client *Client) Statuses() StatusService {
+	return statusService{client}
+}
+
+func (client *Client) Streams() StreamService {
+	return streamService{client}
+}
+
+func (client *Client) Subscriptions() SubscriptionService {
+	return subscriptionService{client}
+}
+
+func (client *Client) Tags() TagService {
+	return tagService{client}
+}
+
+func (client *Client) Tasks() TaskService {
+	return taskService{client}
+}
+
+func (client *Client) Users() UserService {
+	return userService{client}
+}
+
+func (client *Client) Views() ViewService {
+	return viewService{client}
+}
+
+func (client *Client) Widgets() WidgetService {
+	return widgetService{client}
+}
+
+type appService struct {
+	client *Client
+}
+
+func (svc appService) GetApps(ctx context.Context, spaceId SpaceID, options map[string]interface{}) (apps []*App, err error) {
+	return svc.client.GetAppsCtx(ctx, spaceId, options)
+}
+
+func (svc appService) GetApp(ctx context.Context, id AppID) (app *App, err error) {
+	return svc.client.GetAppCtx(ctx, id)
+}
+
+func (svc appService) GetAppBySpaceIdAndSlug(ctx context.Context, spaceId SpaceID, slug string) (app *App, err error) {
+	return svc.client.GetAppBySpaceIdAndSlugCtx(ctx, spaceId, slug)
+}
+
+func (svc appService) GetSpaceDependencies(ctx context.Context, spaceId SpaceID) (response *interface{}, err error) {
+	return svc.client.GetSpaceDependenciesCtx(ctx, spaceId)
+}
+
+func (svc appService) CreateApp(ctx context.Context, spaceId SpaceID, config map[string]interface{}, fields []AppField) (AppId AppID, err error) {
+	return svc.client.CreateAppCtx(ctx, spaceId, config, fields)
+}
+
+func (svc appService) UpdateApp(ctx context.Context, appId AppID, config map[string]interface{}) (err error) {
+	return svc.client.UpdateAppCtx(ctx, appId, config)
+}
+
+func (svc appService) UpdateAppRaw(ctx context.Context, appId AppID, configRaw json.RawMessage) (err error) {
+	return svc.client.UpdateAppRawCtx(ctx, appId, configRaw)
+}
+
+func (svc appService) InstallApp(ctx context.Context, appId AppID, spaceId SpaceID, features []string) (AppId AppID, err error) {
+	return svc.client.InstallAppCtx(ctx, appId, spaceId, features)
+}
+
+func (svc appService) CreateAppField(ctx context.Context, appId AppID, params map[string]interface{}) (AppFieldId FieldID, err error) {
+	return svc.client.CreateAppFieldCtx(ctx, appId, params)
+}
+
+func (svc appService) CreateAppFieldRawConfig(ctx context.Context, appId AppID, config json.RawMessage) (AppFieldId FieldID, err error) {
+	return svc.client.CreateAppFieldRawConfigCtx(ctx, appId, config)
+}
+
+func (svc appService) UpdateAppField(ctx context.Context, appId AppID, appFieldId FieldID, params map[string]interface{}) (revision int, err error) {
+	return svc.client.UpdateAppFieldCtx(ctx, appId, appFieldId, params)
+}
+
+func (svc appService) UpdateAppFieldRawConfig(ctx context.Context, appId AppID, appFieldId FieldID, config json.RawMessage) (int, error) {
+	return svc.client.UpdateAppFieldRawConfigCtx(ctx, appId, appFieldId, config)
+}
+
+func (svc appService) GetFieldRange(ctx context.Context, fieldID FieldID) (FieldRange, error) {
+	return svc.client.GetFieldRangeCtx(ctx, fieldID)
+}
+
+func (svc appService) GetForms(ctx context.Context, appId AppID) (forms []*Form, err error) {
+	return svc.client.GetFormsCtx(ctx, appId)
+}
+
+type batchService struct {
+	client *Client
+}
+
+func (svc batchService) GetBatch(ctx context.Context, batchId int64) (batch *Batch, err error) {
+	return svc.client.GetBatchCtx(ctx, batchId)
+}
+
+type commentService struct {
+	client *Client
+}
+
+func (svc commentService) Comment(ctx context.Context, refType string, refId int64, text string, params map[string]interface{}) (*Comment, error) {
+	return svc.client.CommentCtx(ctx, refType, refId, text, params)
+}
+
+func (svc commentService) UpdateComment(ctx context.Context, commentID int64, text string, params map[string]interface{}) error {
+	return svc.client.UpdateCommentCtx(ctx, commentID, text, params)
+}
+
+func (svc commentService) DeleteComment(ctx context.Context, commentID int64) error {
+	return svc.client.DeleteCommentCtx(ctx, commentID)
+}
+
+func (svc commentService) GetComments(ctx context.Context, refType string, refId int64) (comments []*Comment, err error) {
+	return svc.client.GetCommentsCtx(ctx, refType, refId)
+}
+
+func (svc commentService) GetComment(ctx context.Context, commentId int64) (comment *Comment, err error) {
+	return svc.client.GetCommentCtx(ctx, commentId)
+}
+
+type contactService struct {
+	client *Client
+}
+
+func (svc contactService) GetContacts(ctx context.Context, limit, offset int) (contacts []Contact, err error) {
+	return svc.client.GetContactsCtx(ctx, limit, offset)
+}
+
+func (svc contactService) GetContact(ctx context.Context, userId UserID) (contact Contact, err error) {
+	return svc.client.GetContactCtx(ctx, userId)
+}
+
+type conversationService struct {
+	client *Client
+}
+
+func (svc conversationService) CreateConversation(ctx context.Context, params map[string]interface{}) (c Conversation, err error) {
+	return svc.client.CreateConversationCtx(ctx, params)
+}
+
+func (svc conversationService) ConversationAddParticipants(ctx context.Context, conversationId int64, participants []interface{}) (err error) {
+	return svc.client.ConversationAddParticipantsCtx(ctx, conversationId, participants)
+}
+
+type embedService struct {
+	client *Client
+}
+
+func (svc embedService) CreateEmbed(ctx context.Context, params map[string]interface{}) (embed *Embed, err error) {
+	return svc.client.CreateEmbedCtx(ctx, params)
+}
+
+type fileService struct {
+	client *Client
+}
+
+func (svc fileService) GetFiles(ctx context.Context) (files []File, err error) {
+	return svc.client.GetFilesCtx(ctx)
+}
+
+func (svc fileService) GetFile(ctx context.Context, fileId FileID) (file *File, err error) {
+	return svc.client.GetFileCtx(ctx, fileId)
+}
+
+func (svc fileService) GetFileContents(ctx context.Context, url string) ([]byte, error) {
+	return svc.client.GetFileContentsCtx(ctx, url)
+}
+
+func (svc fileService) GetFileContentsToTempFile(ctx context.Context, url string) (tempFilePath, fileName, mimeType string, close func(), err error) {
+	return svc.client.GetFileContentsToTempFileCtx(ctx, url)
+}
+
+func (svc fileService) FileAndHeaders(ctx context.Context, url string) (tempFilePath string, headers map[string]string, close func(), err error) {
+	return svc.client.FileAndHeadersCtx(ctx, url)
+}
+
+func (svc fileService) CreateFile(ctx context.Context, name string, contents []byte) (file *File, err error) {
+	return svc.client.CreateFileCtx(ctx, name, contents)
+}
+
+func (svc fileService) ReplaceFile(ctx context.Context, oldFileId, newFileId FileID) error {
+	return svc.client.ReplaceFileCtx(ctx, oldFileId, newFileId)
+}
+
+func (svc fileService) AttachFile(ctx context.Context, fileId FileID, refType string, refId int64) error {
+	return svc.client.AttachFileCtx(ctx, fileId, refType, refId)
+}
+
+func (svc fileService) DeleteFile(ctx context.Context, fileId FileID) error {
+	return svc.client.DeleteFileCtx(ctx, fileId)
+}
+
+func (svc fileService) CopyFile(ctx context.Context, fileId FileID) (FileID, error) {
+	return svc.client.CopyFileCtx(ctx, fileId)
+}
+
+func (svc fileService) FindFilesForSpace(ctx context.Context, spaceId SpaceID, params map[string]interface{}) (files []*File, err error) {
+	return svc.client.FindFilesForSpaceCtx(ctx, spaceId, params)
+}
+
+func (svc fileService) FindFilesForApp(ctx context.Context, appId AppID, params map[string]interface{}) (files []*File, err error) {
+	return svc.client.FindFilesForAppCtx(ctx, appId, params)
+}
+
+func (svc fileService) UpdateFile(ctx context.Context, fileId FileID, description string) (err error) {
+	return svc.client.UpdateFileCtx(ctx, fileId, description)
+}
+
+type grantService struct {
+	client *Client
+}
+
+func (svc grantService) CreateGrant(ctx context.Context, refType string, refId int64, params map[string]interface{}) (g GrantResponse, err error) {
+	return svc.client.CreateGrantCtx(ctx, refType, refId, params)
+}
+
+type hookService struct {
+	client *Client
+}
+
+func (svc hookService) CreateHook(ctx context.Context, refType string, refId int64, url string, hookType string) (hook Hook, err error) {
+	return svc.client.CreateHookCtx(ctx, refType, refId, url, hookType)
+}
+
+func (svc hookService) VerifyHook(ctx context.Context, hookId int64) error {
+	return svc.client.VerifyHookCtx(ctx, hookId)
+}
+
+func (svc hookService) ValidateHook(ctx context.Context, hookId int64, code string) error {
+	return svc.client.ValidateHookCtx(ctx, hookId, code)
+}
+
+func (svc hookService) DeleteHook(ctx context.Context, hookId int64) error {
+	return svc.client.DeleteHookCtx(ctx, hookId)
+}
+
+func (svc hookService) FindHooks(ctx context.Context, refType string, refId int64) (hooks []Hook, err error) {
+	return svc.client.FindHooksCtx(ctx, refType, refId)
+}
+
+type itemService struct {
+	client *Client
+}
+
+func (svc itemService) GetItems(ctx context.Context, appId AppID) (items *ItemList, err error) {
+	return svc.client.GetItemsCtx(ctx, appId)
+}
+
+func (svc itemService) GetItemsSimple(ctx context.Context, appId AppID) (items *ItemListSimple, err error) {
+	return svc.client.GetItemsSimpleCtx(ctx, appId)
+}
+
+func (svc itemService) FilterItems(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemList, err error) {
+	return svc.client.FilterItemsCtx(ctx, appId, params)
+}
+
+func (svc itemService) FilterItemsSimple(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemListSimple, err error) {
+	return svc.client.FilterItemsSimpleCtx(ctx, appId, params)
+}
+
+func (svc itemService) FilterItemsSimpleWithCustomFields(ctx context.Context, appId AppID, params map[string]interface{}, fields string) (items *ItemListSimple, err error) {
+	return svc.client.FilterItemsSimpleWithCustomFieldsCtx(ctx, appId, params, fields)
+}
+
+func (svc itemService) FilterItemsMicro(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemListMicro, err error) {
+	return svc.client.FilterItemsMicroCtx(ctx, appId, params)
+}
+
+func (svc itemService) FilterItemsMini(ctx context.Context, appId AppID, params map[string]interface{}) (items *ItemListMini, err error) {
+	return svc.client.FilterItemsMiniCtx(ctx, appId, params)
+}
+
+func (svc itemService) ExportItems(ctx context.Context, appId AppID, exportFormat string, params map[string]interface{}) (int64, error) {
+	return svc.client.ExportItemsCtx(ctx, appId, exportFormat, params)
+}
+
+func (svc itemService) GetItemByAppItemId(ctx context.Context, appId AppID, formattedAppItemId string) (item *Item, err error) {
+	return svc.client.GetItemByAppItemIdCtx(ctx, appId, formattedAppItemId)
+}
+
+func (svc itemService) GetItemSimpleByAppItemId(ctx context.Context, appId AppID, formattedAppItemId string) (item *ItemSimple, err error) {
+	return svc.client.GetItemSimpleByAppItemIdCtx(ctx, appId, formattedAppItemId)
+}
+
+func (svc itemService) GetItemByExternalID(ctx context.Context, appId AppID, externalId string) (item *Item, err error) {
+	return svc.client.GetItemByExternalIDCtx(ctx, appId, externalId)
+}
+
+func (svc itemService) GetItem(ctx context.Context, itemId ItemID) (item *Item, err error) {
+	return svc.client.GetItemCtx(ctx, itemId)
+}
+
+func (svc itemService) GetItemSimple(ctx context.Context, itemId ItemID) (item *ItemSimple, err error) {
+	return svc.client.GetItemSimpleCtx(ctx, itemId)
+}
+
+func (svc itemService) GetItemSimpleByExternalID(ctx context.Context, appId AppID, externalId string) (item *ItemSimple, err error) {
+	return svc.client.GetItemSimpleByExternalIDCtx(ctx, appId, externalId)
+}
+
+func (svc itemService) GetItemMicro(ctx context.Context, itemId ItemID) (item *ItemMicro, err error) {
+	return svc.client.GetItemMicroCtx(ctx, itemId)
+}
+
+func (svc itemService) CreateItem(ctx context.Context, appId AppID, externalId string, fieldValues map[string]interface{}) (ItemID, error) {
+	return svc.client.CreateItemCtx(ctx, appId, externalId, fieldValues)
+}
+
+func (svc itemService) CreateItemThroughParams(ctx context.Context, appId AppID, params, options map[string]interface{}) (item *ItemSimple, err error) {
+	return svc.client.CreateItemThroughParamsCtx(ctx, appId, params, options)
+}
+
+func (svc itemService) UpdateItem(ctx context.Context, itemId ItemID, fieldValues map[string]interface{}) error {
+	return svc.client.UpdateItemCtx(ctx, itemId, fieldValues)
+}
+
+func (svc itemService) UpdateItemWithParams(ctx context.Context, itemId ItemID, params, options map[string]interface{}) (err error) {
+	return svc.client.UpdateItemWithParamsCtx(ctx, itemId, params, options)
+}
+
+func (svc itemService) ItemCount(ctx context.Context, appId AppID, options map[string]interface{}) (count ItemCount, err error) {
+	return svc.client.ItemCountCtx(ctx, appId, options)
+}
+
+func (svc itemService) ItemSearchField(ctx context.Context, AppFieldId FieldID, options map[string]interface{}) (items []Item, err error) {
+	return svc.client.ItemSearchFieldCtx(ctx, AppFieldId, options)
+}
+
+func (svc itemService) ItemClone(ctx context.Context, itemID ItemID, options map[string]interface{}) (clonedItemID ItemID, err error) {
+	return svc.client.ItemCloneCtx(ctx, itemID, options)
+}
+
+func (svc itemService) ItemBulkDelete(ctx context.Context, appID AppID, params map[string]interface{}) (err error) {
+	return svc.client.ItemBulkDeleteCtx(ctx, appID, params)
+}
+
+func (svc itemService) ItemDelete(ctx context.Context, itemID ItemID, params map[string]interface{}) (err error) {
+	return svc.client.ItemDeleteCtx(ctx, itemID, params)
+}
+
+func (svc itemService) GetItemReferences(ctx context.Context, itemID ItemID) (references []*ItemReferences, err error) {
+	return svc.client.GetItemReferencesCtx(ctx, itemID)
+}
+
+func (svc itemService) GetItemReferencesByField(ctx context.Context, itemID ItemID, appFieldID FieldID) (references []*ItemMicro, err error) {
+	return svc.client.GetItemReferencesByFieldCtx(ctx, itemID, appFieldID)
+}
+
+func (svc itemService) IterateItems(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*Item, error] {
+	return svc.client.IterateItemsCtx(ctx, appId, params, opts...)
+}
+
+func (svc itemService) IterateItemsSimple(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemSimple, error] {
+	return svc.client.IterateItemsSimpleCtx(ctx, appId, params, opts...)
+}
+
+func (svc itemService) IterateItemsMicro(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemMicro, error] {
+	return svc.client.IterateItemsMicroCtx(ctx, appId, params, opts...)
+}
+
+func (svc itemService) IterateItemsMini(ctx context.Context, appId AppID, params map[string]interface{}, opts ...IterateOption) iter.Seq2[*ItemMini, error] {
+	return svc.client.IterateItemsMiniCtx(ctx, appId, params, opts...)
+}
+
+func (svc itemService) CloneItemWithValues(ctx context.Context, itemId ItemID, values *ItemValues, options map[string]interface{}) (clonedItemId ItemID, err error) {
+	return svc.client.CloneItemWithValuesCtx(ctx, itemId, values, options)
+}
+
+func (svc itemService) RevertToRevision(ctx context.Context, ItemId ItemID, revisionId int) (rawResponse *json.RawMessage, err error) {
+	return svc.client.RevertToRevisionCtx(ctx, ItemId, revisionId)
+}
+
+func (svc itemService) RevisionsByItemId(ctx context.Context, ItemId ItemID) (revisions []ItemRevision, err error) {
+	return svc.client.RevisionsByItemIdCtx(ctx, ItemId)
+}
+
+func (svc itemService) Importer(ctx context.Context, appId AppID, fileId FileID, params map[string]interface{}) (batchID int64, err error) {
+	return svc.client.ImporterCtx(ctx, appId, fileId, params)
+}
+
+type notificationService struct {
+	client *Client
+}
+
+func (svc notificationService) NotificationMarkAsViewedForRef(ctx context.Context, refType string, refId int64) (statusCode int, err error) {
+	return svc.client.NotificationMarkAsViewedForRefCtx(ctx, refType, refId)
+}
+
+type orgService struct {
+	client *Client
+}
+
+func (svc orgService) GetOrganizations(ctx context.Context) (orgs []Organization, err error) {
+	return svc.client.GetOrganizationsCtx(ctx)
+}
+
+func (svc orgService) GetOrganization(ctx context.Context, id int64) (org *Organization, err error) {
+	return svc.client.GetOrganizationCtx(ctx, id)
+}
+
+func (svc orgService) GetOrganizationBySlug(ctx context.Context, slug string) (org *Organization, err error) {
+	return svc.client.GetOrganizationBySlugCtx(ctx, slug)
+}
+
+type spaceService struct {
+	client *Client
+}
+
+func (svc spaceService) GetSpaces(ctx context.Context, orgId int64) (spaces []Space, err error) {
+	return svc.client.GetSpacesCtx(ctx, orgId)
+}
+
+func (svc spaceService) GetSpace(ctx context.Context, id SpaceID) (space *Space, err error) {
+	return svc.client.GetSpaceCtx(ctx, id)
+}
+
+func (svc spaceService) GetSpaceByOrgIdAndSlug(ctx context.Context, orgId int64, slug string) (space *Space, err error) {
+	return svc.client.GetSpaceByOrgIdAndSlugCtx(ctx, orgId, slug)
+}
+
+func (svc spaceService) CreateSpace(ctx context.Context, orgId int64, name string) (spaceId SpaceID, spaceUrl string, err error) {
+	return svc.client.CreateSpaceCtx(ctx, orgId, name)
+}
+
+func (svc spaceService) UpdateSpace(ctx context.Context, spaceId SpaceID, name string) (err error) {
+	return svc.client.UpdateSpaceCtx(ctx, spaceId, name)
+}
+
+func (svc spaceService) UpdateSpaceUrlLabel(ctx context.Context, spaceId SpaceID, urlLabel string) (err error) {
+	return svc.client.UpdateSpaceUrlLabelCtx(ctx, spaceId, urlLabel)
+}
+
+func (svc spaceService) FindAllForSpace(ctx context.Context, id SpaceID, options map[string]interface{}) (spaceMembers []SpaceMember, err error) {
+	return svc.client.FindAllForSpaceCtx(ctx, id, options)
+}
+
+func (svc spaceService) FindAllForSpaceV1(ctx context.Context, id int64, options map[string]interface{}) (spaceMembers []SpaceMemberV1, err error) {
+	return svc.client.FindAllForSpaceV1Ctx(ctx, id, options)
+}
+
+func (svc spaceService) AddMember(ctx context.Context, id SpaceID, params map[string]interface{}) error {
+	return svc.client.AddMemberCtx(ctx, id, params)
+}
+
+type statusService struct {
+	client *Client
+}
+
+func (svc statusService) StatusCreate(ctx context.Context, spaceId SpaceID, params map[string]interface{}) (s Status, err error) {
+	return svc.client.StatusCreateCtx(ctx, spaceId, params)
+}
+
+func (svc statusService) StatusUpdate(ctx context.Context, statusID int64, params map[string]interface{}) error {
+	return svc.client.StatusUpdateCtx(ctx, statusID, params)
+}
+
+func (svc statusService) StatusDelete(ctx context.Context, statusID int64) error {
+	return svc.client.StatusDeleteCtx(ctx, statusID)
+}
+
+type streamService struct {
+	client *Client
+}
+
+func (svc streamService) StreamForSpaceV3(ctx context.Context, spaceId SpaceID, params map[string]interface{}) (s []Stream, err error) {
+	return svc.client.StreamForSpaceV3Ctx(ctx, spaceId, params)
+}
+
+func (svc streamService) StreamForAppV3References(ctx context.Context, appId AppID, params map[string]interface{}) (s []StreamReference, err error) {
+	return svc.client.StreamForAppV3ReferencesCtx(ctx, appId, params)
+}
+
+type subscriptionService struct {
+	client *Client
+}
+
+func (svc subscriptionService) DeleteSubscription(ctx context.Context, refType string, refId int64) error {
+	return svc.client.DeleteSubscriptionCtx(ctx, refType, refId)
+}
+
+type tagService struct {
+	client *Client
+}
+
+func (svc tagService) CreateTags(ctx context.Context, refType string, refId int64, tags []string) (err error) {
+	return svc.client.CreateTagsCtx(ctx, refType, refId, tags)
+}
+
+func (svc tagService) UpdateTags(ctx context.Context, refType string, refId int64, tags []string) (err error) {
+	return svc.client.UpdateTagsCtx(ctx, refType, refId, tags)
+}
+
+func (svc tagService) ListTopTagsForApp2(ctx context.Context, appId AppID, query string, limit int) (tags []string, err error) {
+	return svc.client.ListTopTagsForApp2Ctx(ctx, appId, query, limit)
+}
+
+func (svc tagService) ListTagsForApp(ctx context.Context, appId AppID, query string, limit int) (tags []*Tag, err error) {
+	return svc.client.ListTagsForAppCtx(ctx, appId, query, limit)
+}
+
+func (svc tagService) ObjectsOnAppWithTag(ctx context.Context, appId AppID, tag string) (tags []*TaggedObject, err error) {
+	return svc.client.ObjectsOnAppWithTagCtx(ctx, appId, tag)
+}
+
+func (svc tagService) DeleteTag(ctx context.Context, refType string, refId int64, text string) (err error) {
+	return svc.client.DeleteTagCtx(ctx, refType, refId, text)
+}
+
+type taskService struct {
+	client *Client
+}
+
+func (svc taskService) GetTask(ctx context.Context, taskID int64) (task Task, err error) {
+	return svc.client.GetTaskCtx(ctx, taskID)
+}
+
+func (svc taskService) GetTasks(ctx context.Context, params map[string]interface{}) (tasks []Task, err error) {
+	return svc.client.GetTasksCtx(ctx, params)
+}
+
+func (svc taskService) GetTaskCount(ctx context.Context, refType string, refId int64) (count TaskCount, err error) {
+	return svc.client.GetTaskCountCtx(ctx, refType, refId)
+}
+
+func (svc taskService) CreateTask(ctx context.Context, appId AppID, params, options map[string]interface{}) (task *Task, err error) {
+	return svc.client.CreateTaskCtx(ctx, appId, params, options)
+}
+
+type userService struct {
+	client *Client
+}
+
+func (svc userService) GetUser(ctx context.Context) (user User, err error) {
+	return svc.client.GetUserCtx(ctx)
+}
+
+func (svc userService) GetUserStatus(ctx context.Context) (user UserStatus, err error) {
+	return svc.client.GetUserStatusCtx(ctx)
+}
+
+type viewService struct {
+	client *Client
+}
+
+func (svc viewService) GetView(ctx context.Context, appID AppID, viewIdOrName interface{}) (v View, err error) {
+	return svc.client.GetViewCtx(ctx, appID, viewIdOrName)
+}
+
+func (svc viewService) GetViews(ctx context.Context, appID AppID) (v []ViewFromList, err error) {
+	return svc.client.GetViewsCtx(ctx, appID)
+}
+
+func (svc viewService) CreateViewWithParams(ctx context.Context, appID AppID, params, options map[string]interface{}) (id int64, err error) {
+	return svc.client.CreateViewWithParamsCtx(ctx, appID, params, options)
+}
+
+func (svc viewService) UpdateViewWithParams(ctx context.Context, viewID int64, params map[string]interface{}) (err error) {
+	return svc.client.UpdateViewWithParamsCtx(ctx, viewID, params)
+}
+
+func (svc viewService) DeleteView(ctx context.Context, viewID int64) error {
+	return svc.client.DeleteViewCtx(ctx, viewID)
+}
+
+type widgetService struct {
+	client *Client
+}
+
+func (svc widgetService) GetWidget(ctx context.Context, widgetID int64) (w Widget, err error) {
+	return svc.client.GetWidgetCtx(ctx, widgetID)
+}
+
+func (svc widgetService) GetWidgets(ctx context.Context, refType string, refID int64) (w []Widget, err error) {
+	return svc.client.GetWidgetsCtx(ctx, refType, refID)
+}
+
+func (svc widgetService) DeleteWidget(ctx context.Context, widgetID int64) (err error) {
+	return svc.client.DeleteWidgetCtx(ctx, widgetID)
+}
+
+func (svc widgetService) CreateWidget(ctx context.Context, refType string, refID int64, params map[string]interface{}) (id int64, err error) {
+	return svc.client.CreateWidgetCtx(ctx, refType, refID, params)
+}
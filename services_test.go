@@ -0,0 +1,34 @@
+package podio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicesCallClient(t *testing.T) {
+	r := require.New(t)
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		paths = append(paths, req.Method+" "+req.URL.Path)
+		w.Write([]byte(`{"item_id": 7, "title": "Launch"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&AuthToken{AccessToken: "token"}, WithBaseURL(server.URL))
+
+	var items ItemService = client.Items()
+	item, err := items.GetItem(context.Background(), 7)
+	r.NoError(err)
+	r.Equal("Launch", item.Title)
+
+	cloned, err := items.ItemClone(context.Background(), 7, nil)
+	r.NoError(err)
+	r.Equal(ItemID(7), cloned)
+
+	r.Equal([]string{"GET /item/7", "POST /item/7/clone"}, paths)
+}
@@ -1,47 +1,76 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Space struct {
-	Id   int64  `json:"space_id"`
-	Slug string `json:"url_label"`
-	Name string `json:"name"`
-	URL  string `json:"url"`
+	Id   SpaceID `json:"space_id"`
+	Slug string  `json:"url_label"`
+	Name string  `json:"name"`
+	URL  string  `json:"url"`
 	// URLLabel string `json:"url_label"`
 	OrgId    int64  `json:"org_id"`
-	Push     Push   `json:"push"`
+	Push     Push   `json:"push,omitzero"`
 	Role     string `json:"role"`
 	Archived bool   `json:"archived"`
 }
 
+func (space Space) MarshalJSON() ([]byte, error) {
+	type plain Space
+	return marshalOmitZero(plain(space))
+}
+
 type spaceIdResponse struct {
-	Id  int64  `json:"space_id"`
-	Url string `json:"url"`
+	Id  SpaceID `json:"space_id"`
+	Url string  `json:"url"`
 }
 
 func (client *Client) GetSpaces(orgId int64) (spaces []Space, err error) {
+	return client.GetSpacesCtx(context.Background(), orgId)
+}
+
+// GetSpacesCtx is the context-aware version of GetSpaces.
+func (client *Client) GetSpacesCtx(ctx context.Context, orgId int64) (spaces []Space, err error) {
 	path := fmt.Sprintf("/org/%d/space", orgId)
-	err = client.Request("GET", path, nil, nil, &spaces)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &spaces)
 	return
 }
 
 func (client *Client) GetSpace(id int64) (space *Space, err error) {
+	return client.GetSpaceCtx(context.Background(), SpaceID(id))
+}
+
+// GetSpaceCtx is the context-aware version of GetSpace.
+func (client *Client) GetSpaceCtx(ctx context.Context, id SpaceID) (space *Space, err error) {
 	path := fmt.Sprintf("/space/%d", id)
-	err = client.Request("GET", path, nil, nil, &space)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &space)
 	return
 }
 
 func (client *Client) GetSpaceByOrgIdAndSlug(orgId int64, slug string) (space *Space, err error) {
+	return client.GetSpaceByOrgIdAndSlugCtx(context.Background(), orgId, slug)
+}
+
+// GetSpaceByOrgIdAndSlugCtx is the context-aware version of GetSpaceByOrgIdAndSlug.
+func (client *Client) GetSpaceByOrgIdAndSlugCtx(ctx context.Context, orgId int64, slug string) (space *Space, err error) {
 	path := fmt.Sprintf("/space/org/%d/%s", orgId, slug)
-	err = client.Request("GET", path, nil, nil, &space)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &space)
 	return
 }
 
 // https://developers.podio.com/doc/spaces/create-space-22390
 func (client *Client) CreateSpace(orgId int64, name string) (spaceId int64, spaceUrl string, err error) {
+	id, url, err := client.CreateSpaceCtx(context.Background(), orgId, name)
+	return int64(id), url, err
+}
+
+// CreateSpaceCtx is the context-aware version of CreateSpace.
+func (client *Client) CreateSpaceCtx(ctx context.Context, orgId int64, name string) (spaceId SpaceID, spaceUrl string, err error) {
 	params := map[string]interface{}{"org_id": orgId, "name": name, "privacy": "closed", "auto_join": false, "post_on_new_app": true, "post_on_new_member": true}
 	var resp spaceIdResponse
-	err = client.RequestWithParams("POST", "/space/", nil, params, &resp)
+	err = client.RequestWithParamsCtx(ctx, "POST", "/space/", nil, params, &resp)
 	spaceId = resp.Id
 	spaceUrl = resp.Url
 
@@ -50,18 +79,28 @@ func (client *Client) CreateSpace(orgId int64, name string) (spaceId int64, spac
 
 // https://developers.podio.com/doc/spaces/update-space-22391
 func (client *Client) UpdateSpace(spaceId int64, name string) (err error) {
+	return client.UpdateSpaceCtx(context.Background(), SpaceID(spaceId), name)
+}
+
+// UpdateSpaceCtx is the context-aware version of UpdateSpace.
+func (client *Client) UpdateSpaceCtx(ctx context.Context, spaceId SpaceID, name string) (err error) {
 	path := fmt.Sprintf("/space/%d", spaceId)
 	params := map[string]interface{}{"name": name}
-	err = client.RequestWithParams("PUT", path, nil, params, nil)
+	err = client.RequestWithParamsCtx(ctx, "PUT", path, nil, params, nil)
 
 	return err
 }
 
 // https://developers.podio.com/doc/spaces/update-space-22391
 func (client *Client) UpdateSpaceUrlLabel(spaceId int64, urlLabel string) (err error) {
+	return client.UpdateSpaceUrlLabelCtx(context.Background(), SpaceID(spaceId), urlLabel)
+}
+
+// UpdateSpaceUrlLabelCtx is the context-aware version of UpdateSpaceUrlLabel.
+func (client *Client) UpdateSpaceUrlLabelCtx(ctx context.Context, spaceId SpaceID, urlLabel string) (err error) {
 	path := fmt.Sprintf("/space/%d", spaceId)
 	params := map[string]interface{}{"url_label": urlLabel}
-	err = client.RequestWithParams("PUT", path, nil, params, nil)
+	err = client.RequestWithParamsCtx(ctx, "PUT", path, nil, params, nil)
 
 	return err
 }
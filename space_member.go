@@ -1,6 +1,9 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type SpaceMember struct {
 	Profile Contact `json:"profile"`
@@ -15,26 +18,49 @@ type SpaceMemberV1 struct {
 
 // Contact describes a Podio contact object
 type ContactSimple struct {
-	ProfileId int    `json:"profile_id"`
-	Name      string `json:"name"`
+	ProfileId ProfileID `json:"profile_id"`
+	Name      string    `json:"name"`
 }
 
 // https://developers.podio.com/doc/space-members/get-space-members-v2-19350328
 func (client *Client) FindAllForSpace(id int64, options map[string]interface{}) (spaceMembers []SpaceMember, err error) {
+	return client.FindAllForSpaceCtx(context.Background(), SpaceID(id), options)
+}
+
+// FindAllForSpaceCtx is the context-aware version of FindAllForSpace.
+func (client *Client) FindAllForSpaceCtx(ctx context.Context, id SpaceID, options map[string]interface{}) (spaceMembers []SpaceMember, err error) {
 	path := fmt.Sprintf("/space/%d/member/v2/", id)
-	err = client.RequestWithParams("GET", path, nil, options, &spaceMembers)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, options, &spaceMembers)
 	return
 }
 
+// PaginateAllForSpace pages through the space members of FindAllForSpace, limit and
+// offset in options are replaced by the page.
+func (client *Client) PaginateAllForSpace(id SpaceID, options map[string]interface{}, opts ...IterateOption) *Paginator[SpaceMember] {
+	return NewPaginator(func(ctx context.Context, limit, offset int) ([]SpaceMember, error) {
+		return client.FindAllForSpaceCtx(ctx, id, pageParams(options, limit, offset))
+	}, maxSpaceMemberLimit, opts...)
+}
+
 // https://developers.podio.com/doc/space-members/get-members-of-space-22395
 func (client *Client) FindAllForSpaceV1(id int64, options map[string]interface{}) (spaceMembers []SpaceMemberV1, err error) {
+	return client.FindAllForSpaceV1Ctx(context.Background(), id, options)
+}
+
+// FindAllForSpaceV1Ctx is the context-aware version of FindAllForSpaceV1.
+func (client *Client) FindAllForSpaceV1Ctx(ctx context.Context, id int64, options map[string]interface{}) (spaceMembers []SpaceMemberV1, err error) {
 	path := fmt.Sprintf("/space/%d/member/", id)
-	err = client.RequestWithParams("GET", path, nil, options, &spaceMembers)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, options, &spaceMembers)
 	return
 }
 
 // https://developers.podio.com/doc/space-members/add-member-to-space-1066259
 func (client *Client) AddMember(id int64, params map[string]interface{}) error {
+	return client.AddMemberCtx(context.Background(), SpaceID(id), params)
+}
+
+// AddMemberCtx is the context-aware version of AddMember.
+func (client *Client) AddMemberCtx(ctx context.Context, id SpaceID, params map[string]interface{}) error {
 	path := fmt.Sprintf("/space/%d/member/", id)
-	return client.RequestWithParams("POST", path, nil, params, nil)
+	return client.RequestWithParamsCtx(ctx, "POST", path, nil, params, nil)
 }
@@ -1,6 +1,9 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Status are messages posted to the space stream
 type Status struct {
@@ -9,19 +12,34 @@ type Status struct {
 
 // https://developers.podio.com/doc/status/add-new-status-message-22336
 func (client *Client) StatusCreate(spaceId int64, params map[string]interface{}) (s Status, err error) {
+	return client.StatusCreateCtx(context.Background(), SpaceID(spaceId), params)
+}
+
+// StatusCreateCtx is the context-aware version of StatusCreate.
+func (client *Client) StatusCreateCtx(ctx context.Context, spaceId SpaceID, params map[string]interface{}) (s Status, err error) {
 	path := fmt.Sprintf("/status/space/%d/", spaceId)
-	err = client.RequestWithParams("POST", path, nil, params, &s)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &s)
 	return
 }
 
 // https://developers.podio.com/doc/status/update-a-status-message-22338
 func (client *Client) StatusUpdate(statusID int64, params map[string]interface{}) error {
+	return client.StatusUpdateCtx(context.Background(), statusID, params)
+}
+
+// StatusUpdateCtx is the context-aware version of StatusUpdate.
+func (client *Client) StatusUpdateCtx(ctx context.Context, statusID int64, params map[string]interface{}) error {
 	path := fmt.Sprintf("/status/%d/", statusID)
-	return client.RequestWithParams("PUT", path, nil, params, nil)
+	return client.RequestWithParamsCtx(ctx, "PUT", path, nil, params, nil)
 }
 
 // https://developers.podio.com/doc/status/delete-a-status-message-22339
 func (client *Client) StatusDelete(statusID int64) error {
+	return client.StatusDeleteCtx(context.Background(), statusID)
+}
+
+// StatusDeleteCtx is the context-aware version of StatusDelete.
+func (client *Client) StatusDeleteCtx(ctx context.Context, statusID int64) error {
 	path := fmt.Sprintf("/status/%d/", statusID)
-	return client.Request("DELETE", path, nil, nil, nil)
+	return client.RequestCtx(ctx, "DELETE", path, nil, nil, nil)
 }
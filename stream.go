@@ -1,6 +1,7 @@
 package podio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -15,7 +16,7 @@ type Stream struct {
 }
 
 type StreamApp struct {
-	Id int64 `json:"app_id"`
+	Id AppID `json:"app_id"`
 }
 
 type ActivityGroup struct {
@@ -55,7 +56,7 @@ type ValuesFromTo struct {
 }
 
 type FieldSimple struct {
-	Id int64 `json:"field_id"`
+	Id FieldID `json:"field_id"`
 }
 
 type RefSimple struct {
@@ -83,22 +84,49 @@ type ActivityReference struct {
 // Section: API calls
 
 // https://developers.podio.com/doc/stream/get-space-stream-v3-116373969
+//
+// Deprecated: use StreamForSpaceV3Ctx with CaptureResponseWithBody to also get the raw JSON.
 func (client *Client) StreamForSpaceV3Json(spaceId int64, params map[string]interface{}) (rawResponse *json.RawMessage, err error) {
+	return client.StreamForSpaceV3JsonCtx(context.Background(), SpaceID(spaceId), params)
+}
+
+// StreamForSpaceV3JsonCtx is the context-aware version of StreamForSpaceV3Json.
+//
+// Deprecated: use StreamForSpaceV3Ctx with CaptureResponseWithBody to also get the raw JSON.
+func (client *Client) StreamForSpaceV3JsonCtx(ctx context.Context, spaceId SpaceID, params map[string]interface{}) (rawResponse *json.RawMessage, err error) {
 	path := fmt.Sprintf("/stream/space/%d/v3/", spaceId)
-	err = client.RequestWithParams("GET", path, nil, params, &rawResponse)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, params, &rawResponse)
 	return
 }
 
 // https://developers.podio.com/doc/stream/get-space-stream-v3-116373969
 func (client *Client) StreamForSpaceV3(spaceId int64, params map[string]interface{}) (s []Stream, err error) {
+	return client.StreamForSpaceV3Ctx(context.Background(), SpaceID(spaceId), params)
+}
+
+// StreamForSpaceV3Ctx is the context-aware version of StreamForSpaceV3.
+func (client *Client) StreamForSpaceV3Ctx(ctx context.Context, spaceId SpaceID, params map[string]interface{}) (s []Stream, err error) {
 	path := fmt.Sprintf("/stream/space/%d/v3/", spaceId)
-	err = client.RequestWithParams("GET", path, nil, params, &s)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, params, &s)
 	return
 }
 
+// PaginateStreamForSpaceV3 pages through the stream of StreamForSpaceV3, limit and
+// offset in params are replaced by the page.
+func (client *Client) PaginateStreamForSpaceV3(spaceId SpaceID, params map[string]interface{}, opts ...IterateOption) *Paginator[Stream] {
+	return NewPaginator(func(ctx context.Context, limit, offset int) ([]Stream, error) {
+		return client.StreamForSpaceV3Ctx(ctx, spaceId, pageParams(params, limit, offset))
+	}, maxStreamLimit, opts...)
+}
+
 // https://developers.podio.com/doc/stream/get-application-stream-v3-100406563
 func (client *Client) StreamForAppV3References(appId int64, params map[string]interface{}) (s []StreamReference, err error) {
+	return client.StreamForAppV3ReferencesCtx(context.Background(), AppID(appId), params)
+}
+
+// StreamForAppV3ReferencesCtx is the context-aware version of StreamForAppV3References.
+func (client *Client) StreamForAppV3ReferencesCtx(ctx context.Context, appId AppID, params map[string]interface{}) (s []StreamReference, err error) {
 	path := fmt.Sprintf("/stream/app/%d/v3/", appId)
-	err = client.RequestWithParams("GET", path, nil, params, &s)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, params, &s)
 	return
 }
@@ -1,9 +1,17 @@
 package podio
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // https://developers.podio.com/doc/subscriptions/unsubscribe-by-reference-22410
 func (client *Client) DeleteSubscription(refType string, refId int64) error {
+	return client.DeleteSubscriptionCtx(context.Background(), refType, refId)
+}
+
+// DeleteSubscriptionCtx is the context-aware version of DeleteSubscription.
+func (client *Client) DeleteSubscriptionCtx(ctx context.Context, refType string, refId int64) error {
 	path := fmt.Sprintf("/subscription/%s/%d", refType, refId)
-	return client.Request("DELETE", path, nil, nil, nil)
+	return client.RequestCtx(ctx, "DELETE", path, nil, nil, nil)
 }
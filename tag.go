@@ -2,6 +2,7 @@ package podio
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -22,6 +23,11 @@ type TaggedObject struct {
 
 // https://developers.podio.com/doc/tags/create-tags-22464
 func (client *Client) CreateTags(refType string, refId int64, tags []string) (err error) {
+	return client.CreateTagsCtx(context.Background(), refType, refId, tags)
+}
+
+// CreateTagsCtx is the context-aware version of CreateTags.
+func (client *Client) CreateTagsCtx(ctx context.Context, refType string, refId int64, tags []string) (err error) {
 	path := fmt.Sprintf("/tag/%s/%d/", refType, refId)
 
 	buf, err := json.Marshal(tags)
@@ -30,12 +36,17 @@ func (client *Client) CreateTags(refType string, refId int64, tags []string) (er
 	}
 	body := bytes.NewReader(buf)
 
-	err = client.Request("POST", path, nil, body, nil)
+	err = client.RequestCtx(ctx, "POST", path, nil, body, nil)
 	return
 }
 
 // https://developers.podio.com/doc/tags/update-tags-39859
 func (client *Client) UpdateTags(refType string, refId int64, tags []string) (err error) {
+	return client.UpdateTagsCtx(context.Background(), refType, refId, tags)
+}
+
+// UpdateTagsCtx is the context-aware version of UpdateTags.
+func (client *Client) UpdateTagsCtx(ctx context.Context, refType string, refId int64, tags []string) (err error) {
 	path := fmt.Sprintf("/tag/%s/%d/", refType, refId)
 
 	buf, err := json.Marshal(tags)
@@ -44,38 +55,70 @@ func (client *Client) UpdateTags(refType string, refId int64, tags []string) (er
 	}
 	body := bytes.NewReader(buf)
 
-	err = client.Request("PUT", path, nil, body, nil)
+	err = client.RequestCtx(ctx, "PUT", path, nil, body, nil)
 	return
 }
 
 // https://developers.podio.com/doc/tags/get-tags-on-app-top-68485
 func (client *Client) ListTopTagsForApp2(appId int64, query string, limit int) (tags []string, err error) {
+	return client.ListTopTagsForApp2Ctx(context.Background(), AppID(appId), query, limit)
+}
+
+// ListTopTagsForApp2Ctx is the context-aware version of ListTopTagsForApp2.
+func (client *Client) ListTopTagsForApp2Ctx(ctx context.Context, appId AppID, query string, limit int) (tags []string, err error) {
 	path := fmt.Sprintf("/tag/app/%d/top/?limit=%d&text=%s", appId, limit, query)
-	err = client.RequestWithParams("GET", path, nil, nil, &tags)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, nil, &tags)
 	return
 }
 
 // https://developers.podio.com/doc/tags/get-tags-on-app-22467
 func (client *Client) ListTagsForApp(appId int64, query string, limit int) (tags []*Tag, err error) {
+	return client.ListTagsForAppCtx(context.Background(), AppID(appId), query, limit)
+}
+
+// ListTagsForAppCtx is the context-aware version of ListTagsForApp.
+func (client *Client) ListTagsForAppCtx(ctx context.Context, appId AppID, query string, limit int) (tags []*Tag, err error) {
 	path := fmt.Sprintf("/tag/app/%d/?limit=%d&text=%s", appId, limit, query)
-	err = client.RequestWithParams("GET", path, nil, nil, &tags)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, nil, &tags)
 	return
 }
 
+// PaginateTagsForApp returns the tags of ListTagsForApp as a Paginator. Podio has no
+// offset for tags, so a page requests all tags up to its end and skips the ones before it.
+func (client *Client) PaginateTagsForApp(appId AppID, query string, opts ...IterateOption) *Paginator[*Tag] {
+	return NewPaginator(func(ctx context.Context, limit, offset int) ([]*Tag, error) {
+		tags, err := client.ListTagsForAppCtx(ctx, appId, query, offset+limit)
+		if err != nil || len(tags) <= offset {
+			return nil, err
+		}
+		return tags[offset:], nil
+	}, maxTagLimit, opts...)
+}
+
 // https://developers.podio.com/doc/tags/get-objects-on-app-with-tag-22469
 func (client *Client) ObjectsOnAppWithTag(appId int64, tag string) (tags []*TaggedObject, err error) {
+	return client.ObjectsOnAppWithTagCtx(context.Background(), AppID(appId), tag)
+}
+
+// ObjectsOnAppWithTagCtx is the context-aware version of ObjectsOnAppWithTag.
+func (client *Client) ObjectsOnAppWithTagCtx(ctx context.Context, appId AppID, tag string) (tags []*TaggedObject, err error) {
 	path := fmt.Sprintf("/tag/app/%d/search/?text=%s", appId, tag)
-	err = client.RequestWithParams("GET", path, nil, nil, &tags)
+	err = client.RequestWithParamsCtx(ctx, "GET", path, nil, nil, &tags)
 	return
 }
 
 // https://developers.podio.com/doc/tags/remove-tag-22465
 func (client *Client) DeleteTag(refType string, refId int64, text string) (err error) {
+	return client.DeleteTagCtx(context.Background(), refType, refId, text)
+}
+
+// DeleteTagCtx is the context-aware version of DeleteTag.
+func (client *Client) DeleteTagCtx(ctx context.Context, refType string, refId int64, text string) (err error) {
 	// path := fmt.Sprintf("/tag/%s/%d/?text=%s", refType, refId, text)
 	path := fmt.Sprintf("/tag/%s/%d/", refType, refId)
 	options := map[string]interface{}{"text": text}
 	path, err = client.AddOptionsToPath(path, options)
-	err = client.Request("DELETE", path, nil, nil, nil)
+	err = client.RequestCtx(ctx, "DELETE", path, nil, nil, nil)
 
 	return
 }
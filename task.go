@@ -1,6 +1,7 @@
 package podio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -23,7 +24,7 @@ type Task struct {
 	Ref     TaskRef `json:"ref"`
 	Private bool    `json:"private"`
 
-	SpaceId    int             `json:"space_id"`
+	SpaceId    SpaceID         `json:"space_id"`
 	ExternalId string          `json:"external_id"`
 	Labels     []*TaskLabel    `json:"labels"`
 	Recurrence json.RawMessage `json:"recurrence"`
@@ -41,7 +42,7 @@ type TaskData struct {
 }
 
 type TaskApp struct {
-	Id int64 `json:"app_id"`
+	Id AppID `json:"app_id"`
 }
 
 type TaskLabel struct {
@@ -64,35 +65,72 @@ type TaskCount struct {
 }
 
 // https://developers.podio.com/doc/tasks/get-tasks-77949
+//
+// Deprecated: use GetTasksCtx with CaptureResponseWithBody to also get the raw JSON.
 func (client *Client) GetTasksJson(params map[string]interface{}) (rawResponse *json.RawMessage, err error) {
-	err = client.RequestWithParams("GET", "/task/", nil, params, &rawResponse)
+	return client.GetTasksJsonCtx(context.Background(), params)
+}
+
+// GetTasksJsonCtx is the context-aware version of GetTasksJson.
+//
+// Deprecated: use GetTasksCtx with CaptureResponseWithBody to also get the raw JSON.
+func (client *Client) GetTasksJsonCtx(ctx context.Context, params map[string]interface{}) (rawResponse *json.RawMessage, err error) {
+	err = client.RequestWithParamsCtx(ctx, "GET", "/task/", nil, params, &rawResponse)
 	return
 }
 
 // https://developers.podio.com/doc/tasks/get-task-22413
 func (client *Client) GetTask(taskID int64) (task Task, err error) {
+	return client.GetTaskCtx(context.Background(), taskID)
+}
+
+// GetTaskCtx is the context-aware version of GetTask.
+func (client *Client) GetTaskCtx(ctx context.Context, taskID int64) (task Task, err error) {
 	path := fmt.Sprintf("/task/%d", taskID)
-	err = client.Request("GET", path, nil, nil, &task)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &task)
 	return
 }
 
 // https://developers.podio.com/doc/tasks/get-tasks-77949
 func (client *Client) GetTasks(params map[string]interface{}) (tasks []Task, err error) {
-	err = client.RequestWithParams("GET", "/task/", nil, params, &tasks)
+	return client.GetTasksCtx(context.Background(), params)
+}
+
+// GetTasksCtx is the context-aware version of GetTasks.
+func (client *Client) GetTasksCtx(ctx context.Context, params map[string]interface{}) (tasks []Task, err error) {
+	err = client.RequestWithParamsCtx(ctx, "GET", "/task/", nil, params, &tasks)
 	return
 }
 
+// PaginateTasks pages through the tasks of GetTasks, limit and offset in params are
+// replaced by the page.
+func (client *Client) PaginateTasks(params map[string]interface{}, opts ...IterateOption) *Paginator[Task] {
+	return NewPaginator(func(ctx context.Context, limit, offset int) ([]Task, error) {
+		return client.GetTasksCtx(ctx, pageParams(params, limit, offset))
+	}, maxTaskLimit, opts...)
+}
+
 // https://developers.podio.com/doc/tasks/get-task-count-38316458
 func (client *Client) GetTaskCount(refType string, refId int64) (count TaskCount, err error) {
+	return client.GetTaskCountCtx(context.Background(), refType, refId)
+}
+
+// GetTaskCountCtx is the context-aware version of GetTaskCount.
+func (client *Client) GetTaskCountCtx(ctx context.Context, refType string, refId int64) (count TaskCount, err error) {
 	path := fmt.Sprintf("/task/%s/%d/count", refType, refId)
-	err = client.Request("GET", path, nil, nil, &count)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &count)
 	return
 }
 
 // https://developers.podio.com/doc/tasks/create-task-22419
 func (client *Client) CreateTask(appId int64, params map[string]interface{}, options map[string]interface{}) (task *Task, err error) {
+	return client.CreateTaskCtx(context.Background(), AppID(appId), params, options)
+}
+
+// CreateTaskCtx is the context-aware version of CreateTask.
+func (client *Client) CreateTaskCtx(ctx context.Context, appId AppID, params, options map[string]interface{}) (task *Task, err error) {
 	path := "/task/"
 	path, err = client.AddOptionsToPath(path, options)
-	err = client.RequestWithParams("POST", path, nil, params, &task)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &task)
 	return
 }
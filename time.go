@@ -32,8 +32,13 @@ func (t *Time) UnmarshalJSON(buf []byte) error {
 	return err
 }
 
-func (t *Time) MarshalJSON() ([]byte, error) {
-	s := t.Format(podioLayout)
+// MarshalJSON writes the zero time as null, like Podio sends a missing time.
+// Before, it was written as "0001-01-01 00:00:00".
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	s := t.UTC().Format(podioLayout)
 	return []byte(fmt.Sprintf(`"%s"`, s)), nil
 }
 
@@ -41,7 +46,11 @@ type Timestamp struct {
 	time.Time
 }
 
-func (t *Timestamp) MarshalJSON() ([]byte, error) {
+// MarshalJSON writes the zero time as null, before it was written as -62135596800
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
 	ts := t.Time.Unix()
 	stamp := fmt.Sprint(ts)
 
@@ -49,6 +58,10 @@ func (t *Timestamp) MarshalJSON() ([]byte, error) {
 }
 
 func (t *Timestamp) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		t.Time = time.Time{}
+		return nil
+	}
 	ts, err := strconv.Atoi(string(b))
 	if err != nil {
 		return err
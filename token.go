@@ -0,0 +1,259 @@
+package podio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// refreshMargin is how long before the expiry we already refresh the access token
+const refreshMargin = time.Minute
+
+// TokenStore persists auth tokens so a refreshed token survives restarts.
+// Implementations must be safe for concurrent use.
+type TokenStore interface {
+	// Load returns the stored token, or nil when nothing was stored yet
+	Load() (*AuthToken, error)
+	Save(token *AuthToken) error
+}
+
+// MemoryTokenStore keeps the token in memory
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *AuthToken
+}
+
+func NewMemoryTokenStore(token *AuthToken) *MemoryTokenStore {
+	return &MemoryTokenStore{token: token}
+}
+
+func (s *MemoryTokenStore) Load() (*AuthToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token, nil
+}
+
+func (s *MemoryTokenStore) Save(token *AuthToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+	return nil
+}
+
+// FileTokenStore keeps the token as JSON in a file (written with 0600 permissions)
+type FileTokenStore struct {
+	mu   sync.Mutex
+	Path string
+}
+
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{Path: path}
+}
+
+func (s *FileTokenStore) Load() (*AuthToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	buf, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token := &AuthToken{}
+	if err := json.Unmarshal(buf, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (s *FileTokenStore) Save(token *AuthToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	buf, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	// write to a temp file first so a crash never leaves a half written token behind
+	tmp, err := ioutil.TempFile(filepath.Dir(s.Path), ".podio_token")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(buf); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// WithTokenRefresh lets the client refresh the access token with the refresh token,
+// both shortly before it expires and once when Podio rejects it as invalid.
+// Tokens without ExpiresAt, e.g. ones built by hand, are only refreshed when Podio
+// rejects them.
+func WithTokenRefresh(clientId, clientSecret string) ClientOption {
+	return func(client *Client) {
+		client.clientId = clientId
+		client.clientSecret = clientSecret
+	}
+}
+
+// WithTokenStore saves every refreshed token to store. When NewClient gets a nil
+// token, the token is loaded from store on the first request. Failing to save a
+// token is logged, the client goes on with the refreshed token.
+func WithTokenStore(store TokenStore) ClientOption {
+	return func(client *Client) {
+		client.tokenStore = store
+	}
+}
+
+var errNoAuthToken = errors.New("podio: client has no auth token")
+
+// AuthToken returns the token currently used by the client (it changes after a refresh)
+func (client *Client) AuthToken() *AuthToken {
+	client.tokenMu.Lock()
+	defer client.tokenMu.Unlock()
+	return client.authToken
+}
+
+func (client *Client) canRefresh() bool {
+	return client.clientId != "" && client.clientSecret != ""
+}
+
+// currentToken returns the token to use for the next request, refreshing it first when it is about to expire
+func (client *Client) currentToken(ctx context.Context) (*AuthToken, error) {
+	client.tokenMu.Lock()
+	token := client.authToken
+	if token == nil && client.tokenStore != nil {
+		stored, err := client.tokenStore.Load()
+		if err != nil {
+			client.tokenMu.Unlock()
+			return nil, err
+		}
+		client.authToken = stored
+		token = stored
+	}
+	client.tokenMu.Unlock()
+
+	if token == nil {
+		return nil, errNoAuthToken
+	}
+
+	if client.canRefresh() && token.RefreshToken != "" && token.expiresWithin(refreshMargin) {
+		return client.refreshToken(ctx, token)
+	}
+	return token, nil
+}
+
+// tokenRefresh is a refresh in flight, done is closed once token and err are set
+type tokenRefresh struct {
+	stale *AuthToken
+	done  chan struct{}
+	token *AuthToken
+	err   error
+}
+
+// refreshToken swaps stale for a fresh token. When several goroutines hit an expired token
+// at once only the first one refreshes, the others wait for the token it obtains.
+// tokenMu is not held during the request, so AuthToken and requests with a valid token
+// don't wait for it.
+func (client *Client) refreshToken(ctx context.Context, stale *AuthToken) (*AuthToken, error) {
+	client.tokenMu.Lock()
+	if client.authToken != stale {
+		token := client.authToken
+		client.tokenMu.Unlock()
+		return token, nil
+	}
+	refresh := client.refreshing
+	leader := refresh == nil || refresh.stale != stale
+	if leader {
+		refresh = &tokenRefresh{stale: stale, done: make(chan struct{})}
+		client.refreshing = refresh
+	}
+	client.tokenMu.Unlock()
+
+	if !leader {
+		select {
+		case <-refresh.done:
+			return refresh.token, refresh.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	refresh.token, refresh.err = client.requestRefresh(ctx, stale)
+	client.tokenMu.Lock()
+	if refresh.err == nil && client.authToken == stale {
+		client.authToken = refresh.token
+	}
+	if client.refreshing == refresh {
+		client.refreshing = nil
+	}
+	client.tokenMu.Unlock()
+	close(refresh.done)
+
+	if refresh.err != nil {
+		return nil, refresh.err
+	}
+	client.log().InfoContext(ctx, "refreshed podio access token", "expires_at", refresh.token.ExpiresAt)
+	if client.tokenStore != nil {
+		if err := client.tokenStore.Save(refresh.token); err != nil {
+			client.log().WarnContext(ctx, "saving the refreshed podio access token failed", "error", err)
+		}
+	}
+	return refresh.token, nil
+}
+
+func (client *Client) requestRefresh(ctx context.Context, stale *AuthToken) (*AuthToken, error) {
+	data := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {stale.RefreshToken},
+		"client_id":     {client.clientId},
+		"client_secret": {client.clientSecret},
+	}
+	token, err := client.authRequest(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = stale.RefreshToken
+	}
+	return token, nil
+}
+
+func (t *AuthToken) expiresWithin(d time.Duration) bool {
+	if t.ExpiresAt.IsZero() {
+		return false
+	}
+	return time.Until(t.ExpiresAt) < d
+}
+
+// isInvalidTokenResponse reports whether Podio rejected the access token
+// e.g. {"error": "invalid_token", "error_description": "expired_token"}
+func isInvalidTokenResponse(statusCode int, respBody []byte) bool {
+	if statusCode != 401 {
+		return false
+	}
+	podioErr := &Error{}
+	if err := json.Unmarshal(respBody, podioErr); err != nil {
+		return false
+	}
+	return podioErr.Type == "invalid_token" || podioErr.Type == "unauthorized"
+}
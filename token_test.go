@@ -0,0 +1,112 @@
+package podio
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshOnInvalidToken(t *testing.T) {
+	r := require.New(t)
+
+	var refreshes int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Path == "/oauth/token":
+			if req.FormValue("refresh_token") != "old-refresh" {
+				w.WriteHeader(400)
+				return
+			}
+			atomic.AddInt32(&refreshes, 1)
+			w.Write([]byte(`{"access_token": "new", "refresh_token": "new-refresh", "expires_in": 28800}`))
+		case req.Header.Get("Authorization") == "OAuth2 new":
+			w.Write([]byte(`{"user_id": 1}`))
+		default:
+			w.WriteHeader(401)
+			w.Write([]byte(`{"error": "invalid_token", "error_description": "expired_token"}`))
+		}
+	}))
+	defer server.Close()
+
+	store := NewMemoryTokenStore(nil)
+	client := NewClient(
+		&AuthToken{AccessToken: "old", RefreshToken: "old-refresh"},
+		WithBaseURL(server.URL),
+		WithTokenRefresh("id", "secret"),
+		WithTokenStore(store),
+	)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetUser()
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		r.NoError(err)
+	}
+
+	r.Equal(int32(1), atomic.LoadInt32(&refreshes))
+	stored, err := store.Load()
+	r.NoError(err)
+	r.Equal("new", stored.AccessToken)
+	r.False(stored.ExpiresAt.IsZero())
+}
+
+type failingTokenStore struct{}
+
+func (failingTokenStore) Load() (*AuthToken, error) { return nil, nil }
+func (failingTokenStore) Save(*AuthToken) error     { return errors.New("disk full") }
+
+func TestRefreshKeepsTokenWhenSaveFails(t *testing.T) {
+	r := require.New(t)
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Path == "/oauth/token":
+			<-release
+			w.Write([]byte(`{"access_token": "new", "refresh_token": "new-refresh", "expires_in": 28800}`))
+		case req.Header.Get("Authorization") == "OAuth2 new":
+			w.Write([]byte(`{"user_id": 1}`))
+		default:
+			w.WriteHeader(401)
+			w.Write([]byte(`{"error": "invalid_token", "error_description": "expired_token"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		&AuthToken{AccessToken: "old", RefreshToken: "old-refresh"},
+		WithBaseURL(server.URL),
+		WithTokenRefresh("id", "secret"),
+		WithTokenStore(failingTokenStore{}),
+	)
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.GetUser()
+		errs <- err
+	}()
+
+	// the refresh request doesn't hold the token lock
+	time.Sleep(50 * time.Millisecond)
+	r.Equal("old", client.AuthToken().AccessToken)
+	close(release)
+
+	r.NoError(<-errs)
+	r.Equal("new", client.AuthToken().AccessToken)
+}
@@ -1,8 +1,13 @@
 package podio
 
+import (
+	"context"
+	"time"
+)
+
 // User contains account information
 type User struct {
-	Id        int    `json:"user_id"`
+	Id        UserID `json:"user_id"`
 	Mail      string `json:"mail"`
 	Status    string `json:"status"` // "inactive", "active" or "blacklisted"
 	Locale    string `json:"locale"` // http://en.wikipedia.org/wiki/ISO_639-1
@@ -10,8 +15,16 @@ type User struct {
 	CreatedOn Time   `json:"created_on"`
 }
 
+// Location loads the time zone of the user, UTC when the user has none
+func (user *User) Location() (*time.Location, error) {
+	if user.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(user.Timezone)
+}
+
 type UserSimple struct {
-	Id   int    `json:"user_id"`
+	Id   UserID `json:"user_id"`
 	Mail string `json:"mail"`
 }
 
@@ -24,13 +37,23 @@ type UserStatus struct {
 // GetUser gets account information for current connected user
 // https://developers.podio.com/doc/users/get-user-22378
 func (client *Client) GetUser() (user User, err error) {
-	err = client.Request("GET", "/user", nil, nil, &user)
+	return client.GetUserCtx(context.Background())
+}
+
+// GetUserCtx is the context-aware version of GetUser.
+func (client *Client) GetUserCtx(ctx context.Context) (user User, err error) {
+	err = client.RequestCtx(ctx, "GET", "/user", nil, nil, &user)
 	return
 }
 
 // GetUserStatus gets account as well as profile information for current connected user
 // https://developers.podio.com/doc/users/get-user-status-22480
 func (client *Client) GetUserStatus() (user UserStatus, err error) {
-	err = client.Request("GET", "/user/status", nil, nil, &user)
+	return client.GetUserStatusCtx(context.Background())
+}
+
+// GetUserStatusCtx is the context-aware version of GetUserStatus.
+func (client *Client) GetUserStatusCtx(ctx context.Context) (user UserStatus, err error) {
+	err = client.RequestCtx(ctx, "GET", "/user/status", nil, nil, &user)
 	return
 }
@@ -1,6 +1,7 @@
 package podio
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -77,27 +78,42 @@ type viewGroupingCount struct {
 
 // https://developers.podio.com/doc/views/get-view-27450
 func (client *Client) GetView(appID int64, viewIdOrName interface{}) (v View, err error) {
+	return client.GetViewCtx(context.Background(), AppID(appID), viewIdOrName)
+}
+
+// GetViewCtx is the context-aware version of GetView.
+func (client *Client) GetViewCtx(ctx context.Context, appID AppID, viewIdOrName interface{}) (v View, err error) {
 	path := fmt.Sprintf("/view/app/%d/%v", appID, viewIdOrName)
-	err = client.Request("GET", path, nil, nil, &v)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &v)
 	return
 }
 
 // https://developers.podio.com/doc/views/get-views-27460
 func (client *Client) GetViews(appID int64) (v []ViewFromList, err error) {
+	return client.GetViewsCtx(context.Background(), AppID(appID))
+}
+
+// GetViewsCtx is the context-aware version of GetViews.
+func (client *Client) GetViewsCtx(ctx context.Context, appID AppID) (v []ViewFromList, err error) {
 	path := fmt.Sprintf("/view/app/%d", appID)
-	err = client.Request("GET", path, nil, nil, &v)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &v)
 	return
 }
 
 // https://developers.podio.com/doc/views/get-views-27460
 func (client *Client) CreateViewWithParams(appID int64, params map[string]interface{}, options map[string]interface{}) (id int64, err error) {
+	return client.CreateViewWithParamsCtx(context.Background(), AppID(appID), params, options)
+}
+
+// CreateViewWithParamsCtx is the context-aware version of CreateViewWithParams.
+func (client *Client) CreateViewWithParamsCtx(ctx context.Context, appID AppID, params, options map[string]interface{}) (id int64, err error) {
 	path := fmt.Sprintf("/view/app/%d", appID)
 	path, err = client.AddOptionsToPath(path, options)
 
 	response := struct {
 		ID int64 `json:"view_id"`
 	}{}
-	err = client.RequestWithParams("POST", path, nil, params, &response)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &response)
 	id = response.ID
 
 	return
@@ -105,16 +121,26 @@ func (client *Client) CreateViewWithParams(appID int64, params map[string]interf
 
 // https://developers.podio.com/doc/views/update-view-20069949
 func (client *Client) UpdateViewWithParams(viewID int64, params map[string]interface{}) (err error) {
+	return client.UpdateViewWithParamsCtx(context.Background(), viewID, params)
+}
+
+// UpdateViewWithParamsCtx is the context-aware version of UpdateViewWithParams.
+func (client *Client) UpdateViewWithParamsCtx(ctx context.Context, viewID int64, params map[string]interface{}) (err error) {
 	path := fmt.Sprintf("/view/%d", viewID)
 
-	err = client.RequestWithParams("PUT", path, nil, params, nil)
+	err = client.RequestWithParamsCtx(ctx, "PUT", path, nil, params, nil)
 	return err
 }
 
 // https://developers.podio.com/doc/views/delete-view-27454
 func (client *Client) DeleteView(viewID int64) error {
+	return client.DeleteViewCtx(context.Background(), viewID)
+}
+
+// DeleteViewCtx is the context-aware version of DeleteView.
+func (client *Client) DeleteViewCtx(ctx context.Context, viewID int64) error {
 	path := fmt.Sprintf("/view/%d", viewID)
 
-	err := client.Request("DELETE", path, nil, nil, nil)
+	err := client.RequestCtx(ctx, "DELETE", path, nil, nil, nil)
 	return err
 }
@@ -1,6 +1,7 @@
 package podio
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -24,7 +25,7 @@ type Widget struct {
 type WidgetConfig struct {
 	Layout      string                `json:"layout"` // table
 	Calculation WidgetCalculationView `json:"calculation"`
-	AppID       int64                 `json:"app_id"`
+	AppID       AppID                 `json:"app_id"`
 	Unit        string                `json:"unit"` // user defined, e.g. "people" / "USD" / "rainbows"
 }
 
@@ -63,33 +64,53 @@ type WidgetDataGroup struct {
 
 // https://developers.podio.com/doc/widgets/get-widget-22489
 func (client *Client) GetWidget(widgetID int64) (w Widget, err error) {
+	return client.GetWidgetCtx(context.Background(), widgetID)
+}
+
+// GetWidgetCtx is the context-aware version of GetWidget.
+func (client *Client) GetWidgetCtx(ctx context.Context, widgetID int64) (w Widget, err error) {
 	path := fmt.Sprintf("/widget/%d", widgetID)
-	err = client.Request("GET", path, nil, nil, &w)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &w)
 	return
 }
 
 // https://developers.podio.com/doc/widgets/get-widgets-22494
 func (client *Client) GetWidgets(refType string, refID int64) (w []Widget, err error) {
+	return client.GetWidgetsCtx(context.Background(), refType, refID)
+}
+
+// GetWidgetsCtx is the context-aware version of GetWidgets.
+func (client *Client) GetWidgetsCtx(ctx context.Context, refType string, refID int64) (w []Widget, err error) {
 	path := fmt.Sprintf("/widget/%s/%d", refType, refID)
-	err = client.Request("GET", path, nil, nil, &w)
+	err = client.RequestCtx(ctx, "GET", path, nil, nil, &w)
 	return
 }
 
 // https://developers.podio.com/doc/widgets/delete-widget-22492
 func (client *Client) DeleteWidget(widgetID int64) (err error) {
+	return client.DeleteWidgetCtx(context.Background(), widgetID)
+}
+
+// DeleteWidgetCtx is the context-aware version of DeleteWidget.
+func (client *Client) DeleteWidgetCtx(ctx context.Context, widgetID int64) (err error) {
 	path := fmt.Sprintf("/widget/%d", widgetID)
-	return client.Request("DELETE", path, nil, nil, nil)
+	return client.RequestCtx(ctx, "DELETE", path, nil, nil, nil)
 }
 
 // https://developers.podio.com/doc/widgets/create-widget-22491
 func (client *Client) CreateWidget(refType string, refID int64, params map[string]interface{}) (id int64, err error) {
+	return client.CreateWidgetCtx(context.Background(), refType, refID, params)
+}
+
+// CreateWidgetCtx is the context-aware version of CreateWidget.
+func (client *Client) CreateWidgetCtx(ctx context.Context, refType string, refID int64, params map[string]interface{}) (id int64, err error) {
 	path := fmt.Sprintf("/widget/%s/%d", refType, refID)
 
 	response := struct {
 		ID int64 `json:"widget_id"`
 	}{}
 
-	err = client.RequestWithParams("POST", path, nil, params, &response)
+	err = client.RequestWithParamsCtx(ctx, "POST", path, nil, params, &response)
 	id = response.ID
 	return id, err
 }